@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
 )
 
 type createAccountRequest struct {
@@ -67,7 +68,7 @@ func (server *Server) getAccount(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
+	if account.Owner != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
 		err := errors.New("account doesn't belong to the authenticated user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
@@ -77,8 +78,9 @@ func (server *Server) getAccount(ctx *gin.Context) {
 }
 
 type listAccountsRequest struct {
-	PageID   int32 `form:"pageId" binding:"omitempty,min=0"`
-	PageSize int32 `form:"pageSize" binding:"required,min=5,max=10"`
+	PageID   int32  `form:"pageId" binding:"omitempty,min=0"`
+	PageSize int32  `form:"pageSize" binding:"required,min=5,max=10"`
+	Owner    string `form:"owner" binding:"omitempty,alphanum"`
 }
 
 func (server *Server) listAccounts(ctx *gin.Context) {
@@ -89,11 +91,22 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
+	// staff may list the accounts of any owner
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	owner := authPayload.Username
+	if req.Owner != "" {
+		if req.Owner != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+			err := errors.New("cannot list accounts of another user")
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		owner = req.Owner
+	}
+
 	arg := db.ListAccountsParams{
 		ID:    int64(req.PageID),
 		Limit: req.PageSize,
-		Owner: authPayload.Username,
+		Owner: owner,
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -106,38 +119,19 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, accounts)
 }
 
-type updateAccountRequest struct {
-	Balance float64 `json:"balance" binding:"omitempty,min=0"`
-}
-
-type getAccountUpdateRequest struct {
+type accountStatusURI struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
 
-func (server *Server) updateAccount(ctx *gin.Context) {
-	var req getAccountUpdateRequest
-	var update updateAccountRequest
-
-	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return
-	}
-
-	if err := ctx.ShouldBindJSON(&update); err != nil {
+// closeAccount closes an account of the authenticated user. Closed accounts are kept for their history.
+func (server *Server) closeAccount(ctx *gin.Context) {
+	var uri accountStatusURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
-	arg := db.UpdateAccountParams{
-		ID:      req.ID,
-		Balance: update.Balance,
-		Owner:   authPayload.Username,
-	}
-
-	account, err := server.store.UpdateAccount(ctx, arg)
-
+	account, err := server.store.GetAccount(ctx, uri.ID)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -147,48 +141,75 @@ func (server *Server) updateAccount(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		err := errors.New("account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	server.updateAccountStatus(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: db.AccountStatusActive,
+		ToStatus:   db.AccountStatusClosed,
+		ChangedBy:  authPayload.Username,
+	})
 }
 
-type deleteAccountRequest struct {
-	ID int64 `uri:"id" binding:"required,min=1"`
+type accountStatusRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"`
 }
 
-type deleteAccountResponse struct {
-	ID      int64
-	Message string
+func (server *Server) freezeAccount(ctx *gin.Context) {
+	server.changeAccountStatus(ctx, db.AccountStatusActive, db.AccountStatusFrozen)
 }
 
-func (server *Server) deleteAccount(ctx *gin.Context) {
-	var req deleteAccountRequest
+func (server *Server) unfreezeAccount(ctx *gin.Context) {
+	server.changeAccountStatus(ctx, db.AccountStatusFrozen, db.AccountStatusActive)
+}
 
-	if err := ctx.ShouldBindUri(&req); err != nil {
+func (server *Server) reopenAccount(ctx *gin.Context) {
+	server.changeAccountStatus(ctx, db.AccountStatusClosed, db.AccountStatusActive)
+}
+
+// changeAccountStatus moves an account from one status to another on behalf of a banker, who must give a reason
+func (server *Server) changeAccountStatus(ctx *gin.Context, fromStatus string, toStatus string) {
+	var uri accountStatusURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
-	arg := db.DeleteAccountParams{
-		ID:    req.ID,
-		Owner: authPayload.Username,
+	var req accountStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
 	}
 
-	err := server.store.DeleteAccount(ctx, arg)
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	server.updateAccountStatus(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  uri.ID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Reason:     req.Reason,
+		ChangedBy:  authPayload.Username,
+	})
+}
 
+func (server *Server) updateAccountStatus(ctx *gin.Context, arg db.UpdateAccountStatusTxParams) {
+	result, err := server.store.UpdateAccountStatusTx(ctx, arg)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
 			return
 		}
+		if errors.Is(err, db.ErrAccountStatusChange) || errors.Is(err, db.ErrAccountNotEmpty) || errors.Is(err, db.ErrSystemAccount) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	res := deleteAccountResponse{
-		ID:      req.ID,
-		Message: "account deleted",
-	}
-
-	ctx.JSON(http.StatusOK, res)
+	ctx.JSON(http.StatusOK, result)
 }
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/uwemakan/simplebank/db/mock"
@@ -36,7 +37,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			owner:    account.Owner,
 			currency: account.Currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -58,7 +59,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			owner:    account.Owner,
 			currency: "ZAR",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -78,7 +79,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			owner:    account.Owner,
 			currency: account.Currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -144,7 +145,7 @@ func TestGetAccountAPI(t *testing.T) {
 			name:      "OK",
 			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -161,7 +162,7 @@ func TestGetAccountAPI(t *testing.T) {
 			name:      "NotFound",
 			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -177,7 +178,7 @@ func TestGetAccountAPI(t *testing.T) {
 			name:      "InternalServerError",
 			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -193,7 +194,7 @@ func TestGetAccountAPI(t *testing.T) {
 			name:      "BadRequest",
 			accountID: 0,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -208,7 +209,7 @@ func TestGetAccountAPI(t *testing.T) {
 			name:      "UnAuthorized",
 			accountID: 1,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -220,6 +221,23 @@ func TestGetAccountAPI(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name:      "BankerOtherOwner",
+			accountID: account.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "banker", util.BankerRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, account)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -252,6 +270,8 @@ func randomAccount(owner string) db.Account {
 		Owner:    owner,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Kind:     db.AccountKindCustomer,
+		Status:   db.AccountStatusActive,
 	}
 }
 
@@ -286,7 +306,7 @@ func TestListAccountsAPI(t *testing.T) {
 			pageId:   0,
 			pageSize: 10,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -308,7 +328,7 @@ func TestListAccountsAPI(t *testing.T) {
 			pageId:   0,
 			pageSize: 100,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -328,7 +348,7 @@ func TestListAccountsAPI(t *testing.T) {
 			pageId:   0,
 			pageSize: 10,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -382,16 +402,27 @@ func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Ac
 	require.Len(t, gotAccounts, len(accounts))
 }
 
-func TestUpdateAccountAPI(t *testing.T) {
+func TestCloseAccountAPI(t *testing.T) {
 	user := randomUser(t, util.RandomString(6))
 	account := randomAccount(user.Username)
-	updatedAccount := account
-	updatedAccount.Balance = 0
+	account.Balance = 0
+
+	closedAccount := account
+	closedAccount.Status = db.AccountStatusClosed
+	result := db.UpdateAccountStatusTxResult{
+		Account: closedAccount,
+		StatusChange: db.AccountStatusChange{
+			ID:         util.RandomInt(1, 1000),
+			AccountID:  account.ID,
+			FromStatus: db.AccountStatusActive,
+			ToStatus:   db.AccountStatusClosed,
+			ChangedBy:  user.Username,
+		},
+	}
 
 	testCases := []struct {
 		name          string
 		accountID     int64
-		balance       float64
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
@@ -399,60 +430,66 @@ func TestUpdateAccountAPI(t *testing.T) {
 		{
 			name:      "OK",
 			accountID: account.ID,
-			balance:   updatedAccount.Balance,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
 				store.EXPECT().
-					UpdateAccount(gomock.Any(), gomock.Eq(db.UpdateAccountParams{
-						ID:      updatedAccount.ID,
-						Balance: updatedAccount.Balance,
-						Owner:   user.Username,
+					UpdateAccountStatusTx(gomock.Any(), gomock.Eq(db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountStatusActive,
+						ToStatus:   db.AccountStatusClosed,
+						ChangedBy:  user.Username,
 					})).
 					Times(1).
-					Return(updatedAccount, nil)
+					Return(result, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchAccount(t, recorder.Body, updatedAccount)
+				requireBodyMatchAccountStatusResult(t, recorder.Body, result)
 			},
 		},
 		{
-			name:      "BadRequest Invalid AccountID",
-			accountID: 0,
-			balance:   updatedAccount.Balance,
+			name:      "NotEmpty",
+			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
 				store.EXPECT().
-					UpdateAccount(gomock.Any(), gomock.Eq(db.UpdateAccountParams{
-						ID:      updatedAccount.ID,
-						Balance: updatedAccount.Balance,
-						Owner:   user.Username,
-					})).
-					Times(0)
+					UpdateAccountStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrAccountNotEmpty)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
 			},
 		},
 		{
-			name:      "BadRequest Invalid Balance",
-			accountID: updatedAccount.ID,
-			balance:   -1000,
+			name:      "UnauthorizedUser",
+			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					UpdateAccount(gomock.Any(), gomock.Eq(db.UpdateAccountParams{
-						ID:      updatedAccount.ID,
-						Balance: updatedAccount.Balance,
-						Owner:   user.Username,
-					})).
-					Times(0)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "BadRequest",
+			accountID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -460,20 +497,13 @@ func TestUpdateAccountAPI(t *testing.T) {
 		},
 		{
 			name:      "NotFound",
-			accountID: updatedAccount.ID,
-			balance:   updatedAccount.Balance,
+			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					UpdateAccount(gomock.Any(), gomock.Eq(db.UpdateAccountParams{
-						ID:      updatedAccount.ID,
-						Balance: updatedAccount.Balance,
-						Owner:   user.Username,
-					})).
-					Times(1).
-					Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -481,20 +511,16 @@ func TestUpdateAccountAPI(t *testing.T) {
 		},
 		{
 			name:      "InternalServerError",
-			accountID: updatedAccount.ID,
-			balance:   updatedAccount.Balance,
+			accountID: account.ID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
 				store.EXPECT().
-					UpdateAccount(gomock.Any(), gomock.Eq(db.UpdateAccountParams{
-						ID:      updatedAccount.ID,
-						Balance: updatedAccount.Balance,
-						Owner:   user.Username,
-					})).
+					UpdateAccountStatusTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.Account{}, sql.ErrConnDone)
+					Return(db.UpdateAccountStatusTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -516,14 +542,7 @@ func TestUpdateAccountAPI(t *testing.T) {
 
 			url := fmt.Sprintf("/accounts/%d", tc.accountID)
 
-			data := updateAccountRequest{
-				Balance: tc.balance,
-			}
-			b, err := json.Marshal(data)
-			require.NoError(t, err)
-
-			body := bytes.NewReader(b)
-			request, err := http.NewRequest(http.MethodPatch, url, body)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
 			require.NoError(t, err)
 
 			tc.setupAuth(t, request, server.tokenMaker)
@@ -534,95 +553,112 @@ func TestUpdateAccountAPI(t *testing.T) {
 	}
 }
 
-func TestDeleteAccountAPI(t *testing.T) {
-	user := randomUser(t, util.RandomString(6))
-	account := randomAccount(user.Username)
-	res := deleteAccountResponse{
-		ID:      account.ID,
-		Message: "account deleted",
+func TestFreezeAccountAPI(t *testing.T) {
+	banker := randomUser(t, util.RandomString(6))
+	account := randomAccount(util.RandomOwner())
+
+	frozenAccount := account
+	frozenAccount.Status = db.AccountStatusFrozen
+	result := db.UpdateAccountStatusTxResult{
+		Account: frozenAccount,
+		StatusChange: db.AccountStatusChange{
+			ID:         util.RandomInt(1, 1000),
+			AccountID:  account.ID,
+			FromStatus: db.AccountStatusActive,
+			ToStatus:   db.AccountStatusFrozen,
+			Reason:     "suspicious activity",
+			ChangedBy:  banker.Username,
+		},
 	}
 
 	testCases := []struct {
 		name          string
-		accountID     int64
-		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		path          string
+		body          gin.H
+		role          string
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name:      "OK",
-			accountID: account.ID,
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
-			},
+			name: "Freeze",
+			path: "freeze",
+			body: gin.H{"reason": "suspicious activity"},
+			role: util.BankerRole,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteAccount(gomock.Any(), gomock.Eq(db.DeleteAccountParams{
-						ID:    account.ID,
-						Owner: user.Username,
+					UpdateAccountStatusTx(gomock.Any(), gomock.Eq(db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountStatusActive,
+						ToStatus:   db.AccountStatusFrozen,
+						Reason:     "suspicious activity",
+						ChangedBy:  banker.Username,
 					})).
 					Times(1).
-					Return(nil)
+					Return(result, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchDeleteResponse(t, recorder.Body, res)
+				requireBodyMatchAccountStatusResult(t, recorder.Body, result)
 			},
 		},
 		{
-			name:      "BadRequest",
-			accountID: 0,
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
-			},
+			name: "Unfreeze",
+			path: "unfreeze",
+			body: gin.H{"reason": "cleared by review"},
+			role: util.AdminRole,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteAccount(gomock.Any(), gomock.Eq(db.DeleteAccountParams{
-						ID:    account.ID,
-						Owner: user.Username,
+					UpdateAccountStatusTx(gomock.Any(), gomock.Eq(db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountStatusFrozen,
+						ToStatus:   db.AccountStatusActive,
+						Reason:     "cleared by review",
+						ChangedBy:  banker.Username,
 					})).
-					Times(0)
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{Account: account}, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
 		{
-			name:      "NotFound",
-			accountID: account.ID,
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
-			},
+			name: "ReopenNotClosed",
+			path: "reopen",
+			body: gin.H{"reason": "customer request"},
+			role: util.BankerRole,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteAccount(gomock.Any(), gomock.Eq(db.DeleteAccountParams{
-						ID:    account.ID,
-						Owner: user.Username,
-					})).
+					UpdateAccountStatusTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.ErrRecordNotFound)
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrAccountStatusChange)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
 			},
 		},
 		{
-			name:      "InternalServerError",
-			accountID: account.ID,
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			name: "MissingReason",
+			path: "freeze",
+			body: gin.H{},
+			role: util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
+		},
+		{
+			name: "Depositor",
+			path: "freeze",
+			body: gin.H{"reason": "suspicious activity"},
+			role: util.DepositorRole,
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					DeleteAccount(gomock.Any(), gomock.Eq(db.DeleteAccountParams{
-						ID:    account.ID,
-						Owner: user.Username,
-					})).
-					Times(1).
-					Return(sql.ErrConnDone)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 	}
@@ -635,29 +671,29 @@ func TestDeleteAccountAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			// start test server and send request
 			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
-			url := fmt.Sprintf("/accounts/%d", tc.accountID)
-
-			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			data, err := json.Marshal(tc.body)
 			require.NoError(t, err)
 
-			tc.setupAuth(t, request, server.tokenMaker)
+			url := fmt.Sprintf("/accounts/%d/%s", account.ID, tc.path)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
 
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, banker.Username, tc.role, time.Minute)
 			server.router.ServeHTTP(recorder, request)
 			tc.checkResponse(t, recorder)
 		})
 	}
 }
 
-func requireBodyMatchDeleteResponse(t *testing.T, body *bytes.Buffer, res deleteAccountResponse) {
+func requireBodyMatchAccountStatusResult(t *testing.T, body *bytes.Buffer, result db.UpdateAccountStatusTxResult) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
-	var response deleteAccountResponse
-	err = json.Unmarshal(data, &response)
+	var gotResult db.UpdateAccountStatusTxResult
+	err = json.Unmarshal(data, &gotResult)
 	require.NoError(t, err)
-	require.Equal(t, res, response)
+	require.Equal(t, result, gotResult)
 }
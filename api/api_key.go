@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+var errMissingScope = errors.New("API key is not granted the scope of this route")
+
+// errFrozenKeyOwner is returned for the API keys of a frozen user, which work again once the user is unfrozen
+var errFrozenKeyOwner = errors.New("the owner of the API key is frozen")
+
+type apiKeyResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Scopes     []string  `json:"scopes"`
+	IsRevoked  bool      `json:"isRevoked"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func newAPIKeyResponse(apiKey db.ApiKey) apiKeyResponse {
+	return apiKeyResponse{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Scopes:     apiKey.Scopes,
+		IsRevoked:  apiKey.IsRevoked,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
+
+type createAPIKeyRequest struct {
+	Name      string    `json:"name" binding:"required,max=100"`
+	Scopes    []string  `json:"scopes" binding:"required,min=1,dive,scope"`
+	ExpiresAt time.Time `json:"expiresAt" binding:"required"`
+}
+
+type createAPIKeyResponse struct {
+	APIKey apiKeyResponse `json:"apiKey"`
+	Key    string         `json:"key"`
+}
+
+func (server *Server) createAPIKey(ctx *gin.Context) {
+	var req createAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !req.ExpiresAt.After(time.Now()) || req.ExpiresAt.After(time.Now().Add(token.MaxAPIKeyDuration)) {
+		err := fmt.Errorf("expiresAt must be in the future and within %s", token.MaxAPIKeyDuration)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	key, hashedSecret, err := token.NewAPIKey(id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	apiKey, err := server.store.CreateApiKey(ctx, db.CreateApiKeyParams{
+		ID:           id,
+		Username:     authPayload.Username,
+		Name:         req.Name,
+		HashedSecret: hashedSecret,
+		Scopes:       req.Scopes,
+		ExpiresAt:    req.ExpiresAt,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := createAPIKeyResponse{
+		APIKey: newAPIKeyResponse(apiKey),
+		Key:    key,
+	}
+	ctx.JSON(http.StatusCreated, rsp)
+}
+
+func (server *Server) listAPIKeys(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	apiKeys, err := server.store.ListApiKeys(ctx, authPayload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]apiKeyResponse, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		rsp[i] = newAPIKeyResponse(apiKey)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type revokeAPIKeyRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+func (server *Server) revokeAPIKey(ctx *gin.Context) {
+	var req revokeAPIKeyRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	apiKey, err := server.store.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ID:       uuid.MustParse(req.ID),
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAPIKeyResponse(apiKey))
+}
+
+// checkAPIKey verifies the API key, checks that it was granted scope and records its use
+func (server *Server) checkAPIKey(ctx context.Context, apiKey string, scope string) (*token.Payload, error) {
+	id, secret, err := token.ParseAPIKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := server.store.GetApiKeyWithRole(ctx, id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, token.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	key := row.ApiKey
+	if err := token.VerifyAPIKey(secret, key.HashedSecret, key.IsRevoked, key.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	if row.IsFrozen {
+		return nil, errFrozenKeyOwner
+	}
+
+	if !util.HasScope(key.Scopes, scope) {
+		return nil, errMissingScope
+	}
+
+	if err := server.store.UpdateApiKeyLastUsed(ctx, key.ID); err != nil {
+		return nil, err
+	}
+
+	return token.NewAPIKeyPayload(key.ID, key.Username, row.Role, key.CreatedAt, key.ExpiresAt), nil
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+type externalTxUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type externalTxRequest struct {
+	Amount      int64  `json:"amount" binding:"required,gt=0"`
+	Currency    string `json:"currency" binding:"required,currency"`
+	Reference   string `json:"reference" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=200"`
+}
+
+func (server *Server) createDeposit(ctx *gin.Context) {
+	server.createExternalTx(ctx, server.store.DepositTx)
+}
+
+func (server *Server) createWithdrawal(ctx *gin.Context) {
+	server.createExternalTx(ctx, server.store.WithdrawTx)
+}
+
+// createExternalTx posts a deposit or a withdrawal with externalTx
+func (server *Server) createExternalTx(ctx *gin.Context, externalTx func(ctx context.Context, arg db.ExternalTxParams) (db.ExternalTxResult, error)) {
+	var uri externalTxUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req externalTxRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.validAccount(ctx, uri.ID, req.Currency)
+	if !valid {
+		return
+	}
+
+	result, err := externalTx(ctx, db.ExternalTxParams{
+		AccountID:   account.ID,
+		Amount:      req.Amount,
+		Reference:   req.Reference,
+		Description: req.Description,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
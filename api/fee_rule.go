@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+type createFeeRuleRequest struct {
+	Currency     string `json:"currency" binding:"required,currency"`
+	TransferType string `json:"transferType" binding:"required,transferType"`
+	MinAmount    int64  `json:"minAmount" binding:"min=0"`
+	FlatFee      int64  `json:"flatFee" binding:"min=0"`
+	BasisPoints  int64  `json:"basisPoints" binding:"min=0,max=10000"`
+	MaxFee       int64  `json:"maxFee" binding:"min=0"`
+}
+
+// createFeeRule adds a tier to the price of a currency and transfer type. Admins only.
+func (server *Server) createFeeRule(ctx *gin.Context) {
+	var req createFeeRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rule, err := server.store.CreateFeeRule(ctx, db.CreateFeeRuleParams{
+		Currency:     req.Currency,
+		TransferType: req.TransferType,
+		MinAmount:    req.MinAmount,
+		FlatFee:      req.FlatFee,
+		BasisPoints:  req.BasisPoints,
+		MaxFee:       req.MaxFee,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, rule)
+}
+
+type listFeeRulesRequest struct {
+	PageID   int64 `form:"pageId" binding:"omitempty,min=0"`
+	PageSize int32 `form:"pageSize" binding:"required,min=5,max=10"`
+}
+
+func (server *Server) listFeeRules(ctx *gin.Context) {
+	var req listFeeRulesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rules, err := server.store.ListFeeRules(ctx, db.ListFeeRulesParams{
+		ID:    req.PageID,
+		Limit: req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+}
+
+type deleteFeeRuleRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// deleteFeeRule removes a tier, so transfers it priced fall to the tier below it, or become free
+func (server *Server) deleteFeeRule(ctx *gin.Context) {
+	var req deleteFeeRuleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rule, err := server.store.DeleteFeeRule(ctx, req.ID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rule)
+}
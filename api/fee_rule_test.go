@@ -0,0 +1,248 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestCreateFeeRuleAPI(t *testing.T) {
+	admin := randomUser(t, util.RandomString(6))
+	rule := db.FeeRule{
+		ID:           util.RandomInt(1, 1000),
+		Currency:     util.USD,
+		TransferType: db.TransferTypeInternal,
+		MinAmount:    10000,
+		FlatFee:      25,
+		BasisPoints:  50,
+		MaxFee:       500,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": rule.TransferType,
+				"minAmount":    rule.MinAmount,
+				"flatFee":      rule.FlatFee,
+				"basisPoints":  rule.BasisPoints,
+				"maxFee":       rule.MaxFee,
+			},
+			role: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateFeeRuleParams{
+					Currency:     rule.Currency,
+					TransferType: rule.TransferType,
+					MinAmount:    rule.MinAmount,
+					FlatFee:      rule.FlatFee,
+					BasisPoints:  rule.BasisPoints,
+					MaxFee:       rule.MaxFee,
+				}
+				store.EXPECT().
+					CreateFeeRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rule, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var gotRule db.FeeRule
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotRule)
+				require.NoError(t, err)
+				require.Equal(t, rule, gotRule)
+			},
+		},
+		{
+			name: "DuplicateTier",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": rule.TransferType,
+				"flatFee":      rule.FlatFee,
+			},
+			role: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateFeeRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FeeRule{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "UnsupportedTransferType",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": "instant",
+				"flatFee":      rule.FlatFee,
+			},
+			role: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BasisPointsOver100Percent",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": rule.TransferType,
+				"basisPoints":  10001,
+			},
+			role: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Banker",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": rule.TransferType,
+				"flatFee":      rule.FlatFee,
+			},
+			role: util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"currency":     rule.Currency,
+				"transferType": rule.TransferType,
+				"flatFee":      rule.FlatFee,
+			},
+			role: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateFeeRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FeeRule{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/fee_rules", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteFeeRuleAPI(t *testing.T) {
+	admin := randomUser(t, util.RandomString(6))
+	rule := db.FeeRule{
+		ID:           util.RandomInt(1, 1000),
+		Currency:     util.EUR,
+		TransferType: db.TransferTypeScheduled,
+		FlatFee:      10,
+	}
+
+	testCases := []struct {
+		name          string
+		ruleID        int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			ruleID: rule.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeRule(gomock.Any(), gomock.Eq(rule.ID)).Times(1).Return(rule, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "NotFound",
+			ruleID: rule.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeRule(gomock.Any(), gomock.Eq(rule.ID)).Times(1).Return(db.FeeRule{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidID",
+			ruleID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/fee_rules/%d", tc.ruleID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, util.AdminRole, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+type authorizeHoldRequest struct {
+	AccountID   int64     `json:"accountId" binding:"required,min=1"`
+	ToAccountID int64     `json:"toAccountId" binding:"required,min=1,nefield=AccountID"`
+	Amount      int64     `json:"amount" binding:"required,gt=0"`
+	Currency    string    `json:"currency" binding:"required,currency"`
+	ExpiresAt   time.Time `json:"expiresAt" binding:"required"`
+}
+
+func (server *Server) authorizeHold(ctx *gin.Context) {
+	var req authorizeHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if expiresIn := time.Until(req.ExpiresAt); expiresIn <= 0 || expiresIn > db.MaxHoldDuration {
+		err := fmt.Errorf("expiresAt must be in the future and at most %s away", db.MaxHoldDuration)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.validAccount(ctx, req.AccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		err := errors.New("account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	// a capture moves the held amount as it is, so both accounts must share the currency
+	toAccount, valid := server.validAccount(ctx, req.ToAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.AuthorizeHoldTx(ctx, db.AuthorizeHoldTxParams{
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      req.Amount,
+		Sender:      account.Owner,
+		Recipient:   toAccount.Owner,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrInvalidHold) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
+
+type getHoldRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) getHold(ctx *gin.Context) {
+	var req getHoldRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hold, err := server.store.GetHold(ctx, req.ID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// staff may view any hold, others only the ones they placed or receive
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if !util.IsStaffRole(authPayload.Role) && hold.Sender != authPayload.Username && hold.Recipient != authPayload.Username {
+		ctx.JSON(http.StatusNotFound, errorResponse(db.ErrRecordNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+type captureHoldRequest struct {
+	// Amount is moved to the recipient, the whole hold if omitted; the rest of the hold is released
+	Amount int64 `json:"amount" binding:"omitempty,gt=0"`
+}
+
+func (server *Server) captureHold(ctx *gin.Context) {
+	var uri getHoldRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// an empty body captures the whole hold
+	var req captureHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hold, valid := server.recipientHold(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, db.CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: req.Amount,
+	})
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, transferLimitResponse(limitErr))
+			return
+		}
+		if errors.Is(err, db.ErrHoldNotAuthorized) || errors.Is(err, db.ErrCaptureExceedsHold) ||
+			errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrTransferLimitUnchecked) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
+
+func (server *Server) voidHold(ctx *gin.Context) {
+	var req getHoldRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hold, valid := server.recipientHold(ctx, req.ID)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.VoidHoldTx(ctx, hold.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrHoldNotAuthorized) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// recipientHold loads a hold that the caller may capture or void, which are the recipient and staff
+func (server *Server) recipientHold(ctx *gin.Context, id int64) (db.Hold, bool) {
+	hold, err := server.store.GetHold(ctx, id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return hold, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return hold, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if hold.Recipient != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+		err := errors.New("only the recipient or a banker can capture or void the hold")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return hold, false
+	}
+	return hold, true
+}
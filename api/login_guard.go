@@ -0,0 +1,216 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Subjects that failed logins are counted and locked out for, shared with the gRPC login
+const (
+	lockoutSubjectUsername = "username"
+	lockoutSubjectClientIP = "client_ip"
+)
+
+// maxLoginDelay caps the progressive delay of login attempts
+const maxLoginDelay = 10 * time.Second
+
+// dummyPasswordHash is checked against when the user does not exist,
+// so that unknown usernames take as long to reject as wrong passwords
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte(util.RandomString(16)), bcrypt.DefaultCost)
+
+// loginGuard tracks the failed attempts of one login request's username and client IP
+type loginGuard struct {
+	server        *Server
+	username      string
+	clientIP      string
+	usernameSince time.Time
+	clientIPSince time.Time
+	failures      int64
+}
+
+// loginClientIP is the address failed logins are counted for. It is the address of the connection,
+// never a forwarded header, which the client could change on every attempt to escape the lockout.
+func loginClientIP(ctx *gin.Context) string {
+	return ctx.RemoteIP()
+}
+
+// guardLogin refuses the attempt with TooManyRequests while the username or the client IP
+// is locked out, and otherwise counts the recent failures of the username
+func (server *Server) guardLogin(ctx *gin.Context, username string) (*loginGuard, bool) {
+	guard := &loginGuard{
+		server:   server,
+		username: username,
+		clientIP: loginClientIP(ctx),
+	}
+
+	var valid bool
+	guard.usernameSince, valid = server.loginFailuresSince(ctx, lockoutSubjectUsername, guard.username)
+	if !valid {
+		return nil, false
+	}
+
+	guard.clientIPSince, valid = server.loginFailuresSince(ctx, lockoutSubjectClientIP, guard.clientIP)
+	if !valid {
+		return nil, false
+	}
+
+	var err error
+	guard.failures, err = server.store.CountLoginFailuresByUsername(ctx, db.CountLoginFailuresByUsernameParams{
+		Username: guard.username,
+		Since:    guard.usernameSince,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, false
+	}
+
+	return guard, true
+}
+
+// loginFailuresSince returns the time from which failed logins of the subject count,
+// which is the start of the failure window or the end of the last lockout, whichever is later
+func (server *Server) loginFailuresSince(ctx *gin.Context, subjectType string, subject string) (time.Time, bool) {
+	since := time.Now().Add(-server.config.LoginFailureWindow)
+
+	lockout, err := server.store.GetLatestLoginLockout(ctx, db.GetLatestLoginLockoutParams{
+		SubjectType: subjectType,
+		Subject:     subject,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return since, true
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return since, false
+	}
+
+	if lockout.LockedUntil.After(time.Now()) {
+		err := fmt.Errorf("too many failed login attempts, try again after %s", lockout.LockedUntil.Format(time.RFC3339))
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(err))
+		return since, false
+	}
+
+	if lockout.LockedUntil.After(since) {
+		since = lockout.LockedUntil
+	}
+	return since, true
+}
+
+// delay slows the attempt down, doubling the wait with every recent failure of the username
+func (guard *loginGuard) delay(ctx *gin.Context) bool {
+	if guard.failures == 0 || guard.server.config.LoginDelayBase <= 0 {
+		return true
+	}
+
+	delay := maxLoginDelay
+	if guard.failures < 16 {
+		delay = guard.server.config.LoginDelayBase << (guard.failures - 1)
+	}
+	if delay > maxLoginDelay {
+		delay = maxLoginDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Request.Context().Done():
+		ctx.JSON(http.StatusRequestTimeout, errorResponse(ctx.Request.Context().Err()))
+		return false
+	}
+}
+
+// succeed records a successful login, which resets the failure count of the username
+func (guard *loginGuard) succeed(ctx *gin.Context) bool {
+	_, err := guard.server.store.CreateLoginAttempt(ctx, db.CreateLoginAttemptParams{
+		Username:     guard.username,
+		ClientIp:     guard.clientIP,
+		IsSuccessful: true,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+	return true
+}
+
+// reject records a failed login and responds with Unauthorized and err
+func (guard *loginGuard) reject(ctx *gin.Context, err error) {
+	if failErr := guard.fail(ctx); failErr != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(failErr))
+		return
+	}
+	ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+}
+
+// fail records a failed login and locks out the username or the client IP once they reach their threshold.
+// Unlike the gRPC login, this server has no task distributor, so a lockout is logged but not emailed.
+func (guard *loginGuard) fail(ctx *gin.Context) error {
+	config := guard.server.config
+
+	_, err := guard.server.store.CreateLoginAttempt(ctx, db.CreateLoginAttemptParams{
+		Username:     guard.username,
+		ClientIp:     guard.clientIP,
+		IsSuccessful: false,
+	})
+	if err != nil {
+		return err
+	}
+
+	failures, err := guard.server.store.CountLoginFailuresByUsername(ctx, db.CountLoginFailuresByUsernameParams{
+		Username: guard.username,
+		Since:    guard.usernameSince,
+	})
+	if err != nil {
+		return err
+	}
+
+	if config.LoginLockoutThreshold > 0 && failures >= config.LoginLockoutThreshold {
+		if err := guard.lockOut(ctx, lockoutSubjectUsername, guard.username, failures); err != nil {
+			return err
+		}
+	}
+
+	failures, err = guard.server.store.CountLoginFailuresByClientIp(ctx, db.CountLoginFailuresByClientIpParams{
+		ClientIp: guard.clientIP,
+		Since:    guard.clientIPSince,
+	})
+	if err != nil {
+		return err
+	}
+
+	if config.LoginIPLockoutThreshold > 0 && failures >= config.LoginIPLockoutThreshold {
+		return guard.lockOut(ctx, lockoutSubjectClientIP, guard.clientIP, failures)
+	}
+
+	return nil
+}
+
+func (guard *loginGuard) lockOut(ctx *gin.Context, subjectType string, subject string, failures int64) error {
+	lockout, err := guard.server.store.CreateLoginLockout(ctx, db.CreateLoginLockoutParams{
+		SubjectType:    subjectType,
+		Subject:        subject,
+		FailedAttempts: failures,
+		LockedUntil:    time.Now().Add(guard.server.config.LoginLockoutDuration),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Warn().
+		Str("subject_type", subjectType).
+		Str("subject", subject).
+		Int64("failed_attempts", failures).
+		Time("locked_until", lockout.LockedUntil).
+		Msg("login locked out")
+	return nil
+}
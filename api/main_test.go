@@ -1,31 +1,51 @@
 package api
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
 )
 
-var tokenSymmetricKey = util.RandomString(32)
+var tokenSigningKey = newTokenSigningKey()
 var accessTokenDuration = time.Minute
 
 func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
-		TokenSymmetricKey: tokenSymmetricKey,
+		TokenSigningKey:     tokenSigningKey,
 		AccessTokenDuration: accessTokenDuration,
 	}
 
 	server, err := NewServer(config, store)
 	require.NoError(t, err)
 
+	// tests that don't care about password changes and revocations don't expect their lookups
+	server.passwordChecker = token.NewPasswordChangeChecker(func(ctx context.Context, username string) (time.Time, error) {
+		return time.Time{}, nil
+	}, time.Minute)
+	server.revocationChecker = token.NewRevocationChecker(func(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+		return false, nil
+	}, time.Minute)
+
 	return server
 }
 
+// newTokenSigningKey generates the signing key the test servers share, so that tokens made by one verify on another
+func newTokenSigningKey() string {
+	signingKey, _, err := token.GenerateSigningKey()
+	if err != nil {
+		panic(err)
+	}
+	return signingKey
+}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 	os.Exit(m.Run())
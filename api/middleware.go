@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,10 +14,17 @@ import (
 const (
 	authorizationHeaderKey = "authorization"
 	authorizationTypeBearer = "bearer"
+	authorizationTypeAPIKey = "apikey"
 	authorizationPayloadKey = "authorization_payload"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// apiKeyChecker authenticates an API key that must have been granted scope
+type apiKeyChecker func(ctx context.Context, apiKey string, scope string) (*token.Payload, error)
+
+// authMiddleware verifies the bearer token and rejects it if tokenChecker fails, e.g. because it was revoked
+// or issued before the last password change of its user, or if its role is not one of accessibleRoles.
+// The routes listed in apiKeyScopes also accept an API key granted the scope of the route.
+func authMiddleware(tokenMaker token.Maker, tokenChecker func(ctx context.Context, payload *token.Payload) error, checkAPIKey apiKeyChecker, accessibleRoles []string) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 		if len(authorizationHeader) == 0 {
@@ -32,17 +40,46 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		var payload *token.Payload
 		authorizationType := strings.ToLower(fields[0])
-		if authorizationType != authorizationTypeBearer {
+		switch authorizationType {
+		case authorizationTypeBearer:
+			accessToken := fields[1]
+			var err error
+			payload, err = tokenMaker.VerifyToken(accessToken, token.TokenTypeAccessToken)
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+				return
+			}
+
+			if err := tokenChecker(ctx, payload); err != nil {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+				return
+			}
+		case authorizationTypeAPIKey:
+			scope, ok := apiKeyScopes[ctx.Request.Method+" "+ctx.FullPath()]
+			if !ok {
+				err := errors.New("API keys are not accepted by this route")
+				ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+				return
+			}
+
+			apiKey := fields[1]
+			var err error
+			payload, err = checkAPIKey(ctx, apiKey, scope)
+			if err != nil {
+				ctx.AbortWithStatusJSON(apiKeyErrorStatus(err), errorResponse(err))
+				return
+			}
+		default:
 			err := fmt.Errorf("unsupported authorization type %s", authorizationType)
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
-		accessToken := fields[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
-		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		if !hasRole(payload.Role, accessibleRoles) {
+			err := fmt.Errorf("permission denied for role %q", payload.Role)
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
 			return
 		}
 
@@ -50,3 +87,24 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+func hasRole(role string, roles []string) bool {
+	for _, r := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// apiKeyErrorStatus returns the HTTP status of an error of apiKeyChecker
+func apiKeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errMissingScope):
+		return http.StatusForbidden
+	case errors.Is(err, token.ErrInvalidAPIKey), errors.Is(err, token.ErrRevokedAPIKey), errors.Is(err, token.ErrExpiredAPIKey),
+		errors.Is(err, errFrozenKeyOwner):
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
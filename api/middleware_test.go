@@ -8,8 +8,12 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
 )
 
 func addAuthorization(
@@ -18,9 +22,10 @@ func addAuthorization(
 	tokenMaker token.Maker,
 	authorizationType string,
 	username string,
+	role string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(username, duration)
+	token, payload, err := tokenMaker.CreateToken(username, role, duration, token.TokenTypeAccessToken)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -37,7 +42,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name:          "OK",
 			setupAuth:     func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", util.DepositorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -53,7 +58,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name:          "UnsupportedAuthorization",
 			setupAuth:     func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, "unsupported", "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, "unsupported", "user", util.DepositorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -62,16 +67,25 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name:          "InvalidAuthorizationFormat",
 			setupAuth:     func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, "", "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, "", "user", util.DepositorRole, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name:          "RoleNotAllowed",
+			setupAuth:     func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name:          "ExpiredToken",
 			setupAuth:     func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", -time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", util.DepositorRole, -time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -86,7 +100,7 @@ func TestAuthMiddleware(t *testing.T) {
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.checkToken, server.checkAPIKey, []string{util.DepositorRole}),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -102,3 +116,177 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewarePasswordChanged(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	config := util.Config{
+		TokenSigningKey:     tokenSigningKey,
+		AccessTokenDuration: accessTokenDuration,
+	}
+	server, err := NewServer(config, store)
+	require.NoError(t, err)
+
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, server.checkToken, server.checkAPIKey, allRoles),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	oldRequest, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+	addAuthorization(t, oldRequest, server.tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+
+	user.PasswordChangedAt = time.Now()
+	time.Sleep(time.Millisecond)
+
+	newRequest, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+	addAuthorization(t, newRequest, server.tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+
+	// the user is loaded once, then served from the cache
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, oldRequest)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, newRequest)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestAuthMiddlewareAPIKey(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account := randomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildKey      func(t *testing.T) (db.ApiKey, string)
+		buildStubs    func(store *mockdb.MockStore, apiKey db.ApiKey)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			path: fmt.Sprintf("/accounts/%d", account.ID),
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().
+					UpdateApiKeyLastUsed(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "MissingScope",
+			path: fmt.Sprintf("/accounts/%d", account.ID),
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.TransfersReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().UpdateApiKeyLastUsed(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "RevokedKey",
+			path: fmt.Sprintf("/accounts/%d", account.ID),
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				apiKey, key := randomAPIKey(t, user.Username, util.AccountsReadScope)
+				apiKey.IsRevoked = true
+				return apiKey, key
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownKey",
+			path: fmt.Sprintf("/accounts/%d", account.ID),
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{}, db.ErrRecordNotFound)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "RouteNotAllowed",
+			path: "/sessions",
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().GetApiKeyWithRole(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListActiveSessions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiKey, key := tc.buildKey(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, apiKey)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			require.NoError(t, err)
+
+			request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeAPIKey, key))
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -1,39 +1,89 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
 )
 
+// Roles allowed to access a route
+var (
+	allRoles    = []string{util.DepositorRole, util.BankerRole, util.AdminRole}
+	bankerRoles = []string{util.BankerRole, util.AdminRole}
+	adminRoles  = []string{util.AdminRole}
+)
+
+// apiKeyScopes maps the routes that server-to-server clients may call with an API key
+// to the scope the key needs. Every other route only accepts access tokens.
+var apiKeyScopes = map[string]string{
+	"POST /accounts":                  util.AccountsWriteScope,
+	"GET /accounts":                   util.AccountsReadScope,
+	"GET /accounts/:id":               util.AccountsReadScope,
+	"GET /accounts/:id/statement":     util.AccountsReadScope,
+	"DELETE /accounts/:id":            util.AccountsWriteScope,
+	"POST /transfers":                 util.TransfersWriteScope,
+	"POST /transfers/quote":           util.TransfersReadScope,
+	"GET /transfers":                  util.TransfersReadScope,
+	"GET /transfers/:id":              util.TransfersReadScope,
+	"POST /transfers/:id/reversals":   util.TransfersWriteScope,
+	"POST /holds":                     util.TransfersWriteScope,
+	"GET /holds/:id":                  util.TransfersReadScope,
+	"POST /holds/:id/capture":         util.TransfersWriteScope,
+	"POST /holds/:id/void":            util.TransfersWriteScope,
+	"POST /scheduled_transfers":       util.TransfersWriteScope,
+	"GET /scheduled_transfers":        util.TransfersReadScope,
+	"GET /scheduled_transfers/:id":    util.TransfersReadScope,
+	"PATCH /scheduled_transfers/:id":  util.TransfersWriteScope,
+	"DELETE /scheduled_transfers/:id": util.TransfersWriteScope,
+}
+
 // Server serves HTTP requests for our banking service.
 type Server struct {
 	config util.Config
 	store  db.Store
 	tokenMaker token.Maker
+	rateProvider fx.RateProvider
+	passwordChecker *token.PasswordChangeChecker
+	revocationChecker *token.RevocationChecker
 	router *gin.Engine
 }
 
 // NewServer creates a new HTTP server and setup routing
 func NewServer(config util.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, _, err := token.NewMaker(config.TokenSigningKey, config.TokenVerificationKeys)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	rateProvider, err := fx.NewRateProvider(config.FxRatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate provider: %w", err)
+	}
+
 	server := &Server{
 		config: config,
 		store: store,
 		tokenMaker: tokenMaker,
+		rateProvider: rateProvider,
 	}
+	server.passwordChecker = token.NewPasswordChangeChecker(server.passwordChangedAt, token.PasswordChangeCacheDuration)
+	server.revocationChecker = token.NewRevocationChecker(server.isTokenRevoked, token.RevocationCacheDuration)
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", validCurrency)
+		v.RegisterValidation("role", validRole)
+		v.RegisterValidation("scope", validScope)
+		v.RegisterValidation("frequency", validFrequency)
+		v.RegisterValidation("transferType", validTransferType)
 	}
 
 	server.setupRouter()
@@ -49,21 +99,92 @@ func (server *Server) setupRouter() {
 	router.POST("/users/login", server.loginUser)
 	router.GET("/users/:username", server.getUser)
 
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.checkToken, server.checkAPIKey, allRoles))
 
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts", server.listAccounts)
 	authRoutes.GET("/accounts/:id", server.getAccount)
-	authRoutes.PATCH("/accounts/:id", server.updateAccount)
-	authRoutes.DELETE("/accounts/:id", server.deleteAccount)
+	authRoutes.GET("/accounts/:id/statement", server.getStatement)
+	authRoutes.DELETE("/accounts/:id", server.closeAccount)
 
 	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.POST("/transfers/quote", server.quoteTransfer)
 	authRoutes.GET("/transfers", server.listTransfers)
 	authRoutes.GET("/transfers/:id", server.getTransfer)
+	authRoutes.POST("/transfers/:id/reversals", server.reverseTransfer)
+
+	authRoutes.POST("/holds", server.authorizeHold)
+	authRoutes.GET("/holds/:id", server.getHold)
+	authRoutes.POST("/holds/:id/capture", server.captureHold)
+	authRoutes.POST("/holds/:id/void", server.voidHold)
+
+	authRoutes.POST("/scheduled_transfers", server.createScheduledTransfer)
+	authRoutes.GET("/scheduled_transfers", server.listScheduledTransfers)
+	authRoutes.GET("/scheduled_transfers/:id", server.getScheduledTransfer)
+	authRoutes.PATCH("/scheduled_transfers/:id", server.updateScheduledTransfer)
+	authRoutes.DELETE("/scheduled_transfers/:id", server.cancelScheduledTransfer)
+
+	authRoutes.GET("/sessions", server.listSessions)
+	authRoutes.DELETE("/sessions/:id", server.revokeSession)
+	authRoutes.POST("/sessions/revokeOthers", server.revokeOtherSessions)
+	authRoutes.POST("/users/logout", server.logoutUser)
+
+	authRoutes.POST("/api_keys", server.createAPIKey)
+	authRoutes.GET("/api_keys", server.listAPIKeys)
+	authRoutes.DELETE("/api_keys/:id", server.revokeAPIKey)
+
+	bankerRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.checkToken, server.checkAPIKey, bankerRoles))
+
+	bankerRoutes.POST("/accounts/:id/deposits", server.createDeposit)
+	bankerRoutes.POST("/accounts/:id/withdrawals", server.createWithdrawal)
+	bankerRoutes.POST("/accounts/:id/freeze", server.freezeAccount)
+	bankerRoutes.POST("/accounts/:id/unfreeze", server.unfreezeAccount)
+	bankerRoutes.POST("/accounts/:id/reopen", server.reopenAccount)
+
+	bankerRoutes.POST("/users/:username/freeze", server.freezeUser)
+	bankerRoutes.POST("/users/:username/unfreeze", server.unfreezeUser)
+
+	adminRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.checkToken, server.checkAPIKey, adminRoles))
+
+	adminRoutes.PATCH("/users/:username/role", server.updateUserRole)
+
+	adminRoutes.POST("/fee_rules", server.createFeeRule)
+	adminRoutes.GET("/fee_rules", server.listFeeRules)
+	adminRoutes.DELETE("/fee_rules/:id", server.deleteFeeRule)
 
 	server.router = router
 }
 
+// passwordChangedAt returns the time the user last changed their password
+func (server *Server) passwordChangedAt(ctx context.Context, username string) (time.Time, error) {
+	user, err := server.store.GetUser(ctx, username)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return user.PasswordChangedAt, nil
+}
+
+// isTokenRevoked reports whether the access token was revoked before it expired
+func (server *Server) isTokenRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	return server.store.IsTokenRevoked(ctx, tokenID)
+}
+
+// cacheRevokedTokens makes this server reject the revoked tokens right away,
+// instead of once their cached lookups expire
+func (server *Server) cacheRevokedTokens(revokedTokens []db.RevokedToken) {
+	for _, revokedToken := range revokedTokens {
+		server.revocationChecker.Revoke(revokedToken.ID, revokedToken.ExpiresAt)
+	}
+}
+
+// checkToken rejects access tokens issued before the last password change of their user, and revoked ones
+func (server *Server) checkToken(ctx context.Context, payload *token.Payload) error {
+	if err := server.passwordChecker.Check(ctx, payload); err != nil {
+		return err
+	}
+	return server.revocationChecker.Check(ctx, payload)
+}
+
 // Start runs the HTTP server on a specific address
 func (server *Server) Start(address string) error {
 	return server.router.Run(address)
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+)
+
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"userAgent"`
+	ClientIp  string    `json:"clientIp"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func newSessionResponse(session db.Session) sessionResponse {
+	return sessionResponse{
+		ID:        session.ID,
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+	}
+}
+
+func (server *Server) listSessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	sessions, err := server.store.ListActiveSessions(ctx, authPayload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]sessionResponse, len(sessions))
+	for i, session := range sessions {
+		rsp[i] = newSessionResponse(session)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type revokeSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+func (server *Server) revokeSession(ctx *gin.Context) {
+	var req revokeSessionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.BlockSessionParams{
+		ID:       uuid.MustParse(req.ID),
+		Username: authPayload.Username,
+	}
+
+	result, err := server.store.BlockSessionTx(ctx, arg)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	ctx.JSON(http.StatusOK, newSessionResponse(result.Session))
+}
+
+type currentSessionRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+type revokeOtherSessionsResponse struct {
+	RevokedCount int64 `json:"revokedCount"`
+}
+
+func (server *Server) revokeOtherSessions(ctx *gin.Context) {
+	var req currentSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, valid := server.currentSession(ctx, req.RefreshToken)
+	if !valid {
+		return
+	}
+
+	if session.IsBlocked {
+		err := errors.New("blocked session")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.BlockOtherSessionsTx(ctx, db.BlockOtherSessionsParams{
+		Username: session.Username,
+		FamilyID: session.FamilyID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	ctx.JSON(http.StatusOK, revokeOtherSessionsResponse{RevokedCount: result.BlockedCount})
+}
+
+func (server *Server) logoutUser(ctx *gin.Context) {
+	var req currentSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, valid := server.currentSession(ctx, req.RefreshToken)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.BlockSessionTx(ctx, db.BlockSessionParams{
+		ID:       session.ID,
+		Username: session.Username,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// currentSession returns the session that the refresh token belongs to,
+// after checking that it is owned by the authenticated user
+func (server *Server) currentSession(ctx *gin.Context, refreshToken string) (db.Session, bool) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken, token.TokenTypeRefreshToken)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return db.Session{}, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if refreshPayload.Username != authPayload.Username {
+		err := errors.New("session doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return db.Session{}, false
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return session, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return session, false
+	}
+
+	if session.Username != authPayload.Username || session.RefreshToken != refreshToken {
+		err := errors.New("mismatched session token")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return session, false
+	}
+
+	if session.IsRotated {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(db.ErrSessionRotated))
+		return session, false
+	}
+
+	return session, true
+}
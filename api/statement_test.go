@@ -0,0 +1,185 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestGetStatementAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account := randomAccount(user.Username)
+
+	toTime := time.Now().UTC().Truncate(time.Second)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	statement := db.StatementTxResult{
+		AccountID:      account.ID,
+		FromTime:       fromTime,
+		ToTime:         toTime,
+		OpeningBalance: 1000,
+		Lines: []db.StatementLine{
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					Entry: db.Entry{
+						ID:        util.RandomInt(1, 1000),
+						AccountID: account.ID,
+						Amount:    -300,
+					},
+					JournalKind:           db.JournalKindTransfer,
+					TransferID:            util.RandomInt(1, 1000),
+					CounterpartyAccountID: util.RandomInt(1, 1000),
+					Counterparty:          util.RandomOwner(),
+				},
+				Balance: 700,
+			},
+		},
+		ClosingBalance: 700,
+	}
+
+	testCases := []struct {
+		name          string
+		accountID     int64
+		from          string
+		to            string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			from:      fromTime.Format(time.RFC3339),
+			to:        toTime.Format(time.RFC3339),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				arg := db.StatementTxParams{
+					AccountID: account.ID,
+					FromTime:  fromTime,
+					ToTime:    toTime,
+				}
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(statement, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotStatement statementResponse
+				err = json.Unmarshal(data, &gotStatement)
+				require.NoError(t, err)
+				require.Equal(t, account.Currency, gotStatement.Currency)
+				require.Equal(t, statement.OpeningBalance, gotStatement.OpeningBalance)
+				require.Equal(t, statement.ClosingBalance, gotStatement.ClosingBalance)
+				require.Equal(t, statement.Lines, gotStatement.Lines)
+			},
+		},
+		{
+			name:      "UnauthorizedUser",
+			accountID: account.ID,
+			from:      fromTime.Format(time.RFC3339),
+			to:        toTime.Format(time.RFC3339),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidPeriod",
+			accountID: account.ID,
+			from:      toTime.Format(time.RFC3339),
+			to:        fromTime.Format(time.RFC3339),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.StatementTxResult{}, db.ErrInvalidStatementPeriod)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidTime",
+			accountID: account.ID,
+			from:      "yesterday",
+			to:        toTime.Format(time.RFC3339),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			query := url.Values{}
+			query.Add("from", tc.from)
+			query.Add("to", tc.to)
+			url := fmt.Sprintf("/accounts/%d/statement?%s", tc.accountID, query.Encode())
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -7,14 +7,21 @@ import (
 
 	"github.com/gin-gonic/gin"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
 )
 
 type transferRequest struct {
-	FromAccountID int64   `json:"fromAccountId" binding:"required,min=1"`
-	ToAccountID   int64   `json:"toAccountId" binding:"required,min=1"`
-	Amount        float64 `json:"amount" binding:"required,gt=0"`
-	Currency      string  `json:"currency" binding:"required,currency"`
+	FromAccountID int64  `json:"fromAccountId" binding:"required,min=1"`
+	ToAccountID   int64  `json:"toAccountId" binding:"required,min=1"`
+	Amount        int64  `json:"amount" binding:"required,gt=0"`
+	Currency      string `json:"currency" binding:"required,currency"`
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -36,7 +43,8 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	toAccount, valid := server.validAccount(ctx, req.ToAccountID, req.Currency)
+	// the destination account may hold a different currency, the amount is then converted
+	toAccount, valid := server.validAccount(ctx, req.ToAccountID, "")
 	if !valid {
 		return
 	}
@@ -49,8 +57,45 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		Recipient:     toAccount.Owner,
 	}
 
+	if toAccount.Currency != fromAccount.Currency {
+		conversion, err := fx.Convert(ctx, server.rateProvider, req.Amount, fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) {
+				ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		arg.ToAmount = conversion.ToAmount
+		arg.ExchangeRate = conversion.RateString()
+	}
+
+	if idempotencyKey := ctx.GetHeader(idempotencyKeyHeader); idempotencyKey != "" {
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			err := fmt.Errorf("%s header must not exceed %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		arg.IdempotencyKey = idempotencyKey
+		arg.RequestHash = db.HashTransferRequest(req.FromAccountID, req.ToAccountID, req.Amount, req.Currency)
+	}
+
 	result, err := server.store.TransferTx(ctx, arg)
 	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, transferLimitResponse(limitErr))
+			return
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrIdempotencyKeyReused) ||
+			errors.Is(err, db.ErrSystemAccount) || errors.Is(err, db.ErrAccountNotActive) ||
+			errors.Is(err, db.ErrTransferLimitUnchecked) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -58,6 +103,106 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, result)
 }
 
+type quoteTransferRequest struct {
+	FromAccountID int64  `json:"fromAccountId" binding:"required,min=1"`
+	ToAccountID   int64  `json:"toAccountId" binding:"required,min=1,nefield=FromAccountID"`
+	Amount        int64  `json:"amount" binding:"required,gt=0"`
+	Currency      string `json:"currency" binding:"required,currency"`
+	Scheduled     bool   `json:"scheduled"`
+}
+
+type quoteTransferResponse struct {
+	TransferType string `json:"transferType"`
+	Amount       int64  `json:"amount"`
+	Fee          int64  `json:"fee"`
+	TotalAmount  int64  `json:"totalAmount"`
+	Currency     string `json:"currency"`
+	ToAmount     int64  `json:"toAmount"`
+	ToCurrency   string `json:"toCurrency"`
+	ExchangeRate string `json:"exchangeRate"`
+}
+
+func (server *Server) quoteTransfer(ctx *gin.Context) {
+	var req quoteTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if fromAccount.Owner != authPayload.Username {
+		err := errors.New("from account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	// scheduled transfers are never converted, other transfers may go to an account of another currency
+	toCurrency := ""
+	if req.Scheduled {
+		toCurrency = req.Currency
+	}
+
+	toAccount, valid := server.validAccount(ctx, req.ToAccountID, toCurrency)
+	if !valid {
+		return
+	}
+
+	rsp := quoteTransferResponse{
+		TransferType: db.TransferType(fromAccount.Currency, toAccount.Currency, req.Scheduled),
+		Amount:       req.Amount,
+		Currency:     fromAccount.Currency,
+		ToAmount:     req.Amount,
+		ToCurrency:   toAccount.Currency,
+		ExchangeRate: "1",
+	}
+
+	if toAccount.Currency != fromAccount.Currency {
+		conversion, err := fx.Convert(ctx, server.rateProvider, req.Amount, fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) {
+				ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		rsp.ToAmount = conversion.ToAmount
+		rsp.ExchangeRate = conversion.RateString()
+	}
+
+	fee, err := db.TransferFee(ctx, server.store, db.TransferFeeParams{
+		Currency:     fromAccount.Currency,
+		TransferType: rsp.TransferType,
+		Amount:       req.Amount,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	rsp.Fee = fee
+	rsp.TotalAmount = req.Amount + fee
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// transferLimitResponse reports the exceeded transfer limit with the allowance it has left
+func transferLimitResponse(limitErr *db.TransferLimitError) gin.H {
+	return gin.H{
+		"error":     limitErr.Error(),
+		"limit":     limitErr.Limit,
+		"currency":  limitErr.Currency,
+		"remaining": limitErr.Remaining,
+	}
+}
+
+// validAccount loads the account and checks that it holds the given currency.
+// An empty currency skips the currency check.
 func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency string) (db.Account, bool) {
 	account, err := server.store.GetAccount(ctx, accountID)
 
@@ -70,7 +215,7 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 		return account, false
 	}
 
-	if account.Currency != currency {
+	if currency != "" && account.Currency != currency {
 		err = fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return account, false
@@ -91,13 +236,18 @@ func (server *Server) getTransfer(ctx *gin.Context) {
 		return
 	}
 
+	// staff may view any transfer, others only the ones they sent or received
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	arg := db.GetTransferParams{
-		ID:       req.ID,
-		Username: authPayload.Username,
+	var result db.Transfer
+	var err error
+	if util.IsStaffRole(authPayload.Role) {
+		result, err = server.store.GetTransferById(ctx, req.ID)
+	} else {
+		result, err = server.store.GetTransfer(ctx, db.GetTransferParams{
+			ID:       req.ID,
+			Username: authPayload.Username,
+		})
 	}
-
-	result, err := server.store.GetTransfer(ctx, arg)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+type reverseTransferRequest struct {
+	// Amount is sent back in minor units of the recipient's currency, the whole unrefunded amount if omitted
+	Amount int64  `json:"amount" binding:"omitempty,gt=0"`
+	Reason string `json:"reason" binding:"max=200"`
+}
+
+func (server *Server) reverseTransfer(ctx *gin.Context) {
+	var uri getTransferRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// an empty body reverses the whole transfer
+	var req reverseTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	transfer, err := server.store.GetTransferById(ctx, uri.ID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// the recipient holds the money, so only they or staff may send it back
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if transfer.Recipient != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+		err := errors.New("only the recipient or a banker can reverse the transfer")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID: transfer.ID,
+		Amount:     req.Amount,
+		Reason:     req.Reason,
+		ReversedBy: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRefundExceedsTransfer) || errors.Is(err, db.ErrReversalNotReversible) ||
+			errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrRefundTooSmall) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
@@ -11,10 +11,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/uwemakan/simplebank/db/mock"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
 )
@@ -33,7 +35,7 @@ func TestCreateTransferAPI(t *testing.T) {
 		name          string
 		fromAccountID int64
 		toAccountID   int64
-		amount        float64
+		amount        int64
 		currency      string
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
@@ -46,7 +48,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -76,7 +78,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -94,7 +96,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -112,7 +114,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      util.USD,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -140,7 +142,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -158,7 +160,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -176,7 +178,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      "ABC",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -203,7 +205,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -225,6 +227,65 @@ func TestCreateTransferAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name:          "InsufficientFunds",
+			fromAccountID: transferTx.FromAccount.ID,
+			toAccountID:   transferTx.ToAccount.ID,
+			amount:        transferTx.Transfer.Amount,
+			currency:      currency,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.FromAccount.ID)).Times(1).Return(transferTx.FromAccount, nil),
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.ToAccount.ID)).Times(1).Return(transferTx.ToAccount, nil),
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.TransferTxResult{}, db.ErrInsufficientFunds),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:          "TransferLimitExceeded",
+			fromAccountID: transferTx.FromAccount.ID,
+			toAccountID:   transferTx.ToAccount.ID,
+			amount:        transferTx.Transfer.Amount,
+			currency:      currency,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.FromAccount.ID)).Times(1).Return(transferTx.FromAccount, nil),
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.ToAccount.ID)).Times(1).Return(transferTx.ToAccount, nil),
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.TransferTxResult{}, &db.TransferLimitError{
+							Limit:     db.TransferLimitMaxAmount,
+							Currency:  currency,
+							Remaining: 100,
+						}),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var rsp struct {
+					Limit     string `json:"limit"`
+					Remaining int64  `json:"remaining"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, db.TransferLimitMaxAmount, rsp.Limit)
+				require.Equal(t, int64(100), rsp.Remaining)
+			},
+		},
 		{
 			name:          "Unauthorized",
 			fromAccountID: transferTx.FromAccount.ID,
@@ -248,7 +309,7 @@ func TestCreateTransferAPI(t *testing.T) {
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "owner", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "owner", util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				gomock.InOrder(
@@ -260,13 +321,13 @@ func TestCreateTransferAPI(t *testing.T) {
 			},
 		},
 		{
-			name:          "ToAccountCurrencyMismatchBadRequest",
+			name:          "ExchangeRateNotFound",
 			fromAccountID: transferTx.FromAccount.ID,
 			toAccountID:   transferTx.ToAccount.ID,
 			amount:        transferTx.Transfer.Amount,
 			currency:      currency,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				transferTx.ToAccount.Currency = util.USD
@@ -285,7 +346,7 @@ func TestCreateTransferAPI(t *testing.T) {
 				)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
 			},
 		},
 	}
@@ -326,6 +387,337 @@ func TestCreateTransferAPI(t *testing.T) {
 	}
 }
 
+func TestCreateCrossCurrencyTransferAPI(t *testing.T) {
+	transferTx := randomTransferTx(t)
+
+	transferTx.FromAccount.Currency = util.USD
+	transferTx.ToAccount.Currency = util.EUR
+	transferTx.Transfer.Amount = 1000
+
+	owner := transferTx.FromAccount.Owner
+	recipient := transferTx.ToAccount.Owner
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.FromAccount.ID)).Times(1).Return(transferTx.FromAccount, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.ToAccount.ID)).Times(1).Return(transferTx.ToAccount, nil),
+		store.EXPECT().
+			TransferTx(gomock.Any(), gomock.Eq(db.TransferTxParams{
+				FromAccountID: transferTx.FromAccount.ID,
+				ToAccountID:   transferTx.ToAccount.ID,
+				Amount:        1000,
+				Sender:        owner,
+				Recipient:     recipient,
+				ToAmount:      920,
+				ExchangeRate:  "0.9200000000",
+			})).
+			Times(1).
+			Return(transferTx, nil),
+	)
+
+	server := newTestServer(t, store)
+	rateProvider, err := fx.NewStaticRateProvider(map[string]string{"USD/EUR": "0.92"})
+	require.NoError(t, err)
+	server.rateProvider = rateProvider
+
+	data := transferRequest{
+		FromAccountID: transferTx.FromAccount.ID,
+		ToAccountID:   transferTx.ToAccount.ID,
+		Amount:        1000,
+		Currency:      util.USD,
+	}
+
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(b))
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	requireBodyMatchTransferTx(t, recorder.Body, transferTx)
+}
+
+func TestQuoteTransferAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account1 := randomAccount(user.Username)
+	account1.Currency = util.USD
+	account2 := randomAccount(util.RandomOwner())
+	account2.ID = account1.ID + 1
+	account2.Currency = util.USD
+	account3 := randomAccount(util.RandomOwner())
+	account3.ID = account1.ID + 2
+	account3.Currency = util.EUR
+
+	amount := int64(1000)
+	rule := db.FeeRule{
+		Currency:     util.USD,
+		TransferType: db.TransferTypeScheduled,
+		FlatFee:      5,
+		BasisPoints:  100,
+		MaxFee:       12,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Scheduled",
+			body: gin.H{
+				"fromAccountId": account1.ID,
+				"toAccountId":   account2.ID,
+				"amount":        amount,
+				"currency":      util.USD,
+				"scheduled":     true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				arg := db.GetFeeRuleParams{
+					Currency:     util.USD,
+					TransferType: db.TransferTypeScheduled,
+					Amount:       amount,
+				}
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rule, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp quoteTransferResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, quoteTransferResponse{
+					TransferType: db.TransferTypeScheduled,
+					Amount:       amount,
+					Fee:          12,
+					TotalAmount:  amount + 12,
+					Currency:     util.USD,
+					ToAmount:     amount,
+					ToCurrency:   util.USD,
+					ExchangeRate: "1",
+				}, rsp)
+			},
+		},
+		{
+			name: "CrossCurrency",
+			body: gin.H{
+				"fromAccountId": account1.ID,
+				"toAccountId":   account3.ID,
+				"amount":        amount,
+				"currency":      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Any()).Times(1).Return(db.FeeRule{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp quoteTransferResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, db.TransferTypeCrossCurrency, rsp.TransferType)
+				require.Zero(t, rsp.Fee)
+				require.Equal(t, int64(920), rsp.ToAmount)
+				require.Equal(t, "0.9200000000", rsp.ExchangeRate)
+			},
+		},
+		{
+			name: "Unauthorized",
+			body: gin.H{
+				"fromAccountId": account2.ID,
+				"toAccountId":   account1.ID,
+				"amount":        amount,
+				"currency":      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"fromAccountId": account1.ID,
+				"toAccountId":   account2.ID,
+				"amount":        amount,
+				"currency":      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Any()).Times(1).Return(db.FeeRule{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidAmount",
+			body: gin.H{
+				"fromAccountId": account1.ID,
+				"toAccountId":   account2.ID,
+				"amount":        -1,
+				"currency":      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			rateProvider, err := fx.NewStaticRateProvider(map[string]string{"USD/EUR": "0.92"})
+			require.NoError(t, err)
+			server.rateProvider = rateProvider
+
+			b, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers/quote", bytes.NewReader(b))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+
+			recorder := httptest.NewRecorder()
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateTransferIdempotencyAPI(t *testing.T) {
+	transferTx := randomTransferTx(t)
+	currency := util.RUB
+
+	transferTx.FromAccount.Currency = currency
+	transferTx.ToAccount.Currency = currency
+
+	owner := transferTx.FromAccount.Owner
+	recipient := transferTx.ToAccount.Owner
+
+	req := transferRequest{
+		FromAccountID: transferTx.FromAccount.ID,
+		ToAccountID:   transferTx.ToAccount.ID,
+		Amount:        transferTx.Transfer.Amount,
+		Currency:      currency,
+	}
+	requestHash := db.HashTransferRequest(req.FromAccountID, req.ToAccountID, req.Amount, req.Currency)
+
+	idempotencyKey := util.RandomString(32)
+
+	testCases := []struct {
+		name           string
+		idempotencyKey string
+		buildStubs     func(store *mockdb.MockStore)
+		checkResponse  func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "Created",
+			idempotencyKey: idempotencyKey,
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.FromAccount.ID)).Times(1).Return(transferTx.FromAccount, nil),
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.ToAccount.ID)).Times(1).Return(transferTx.ToAccount, nil),
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Eq(db.TransferTxParams{
+							FromAccountID:  transferTx.FromAccount.ID,
+							ToAccountID:    transferTx.ToAccount.ID,
+							Amount:         transferTx.Transfer.Amount,
+							Sender:         owner,
+							Recipient:      recipient,
+							IdempotencyKey: idempotencyKey,
+							RequestHash:    requestHash,
+						})).
+						Times(1).
+						Return(transferTx, nil),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchTransferTx(t, recorder.Body, transferTx)
+			},
+		},
+		{
+			name:           "KeyReused",
+			idempotencyKey: idempotencyKey,
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.FromAccount.ID)).Times(1).Return(transferTx.FromAccount, nil),
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(transferTx.ToAccount.ID)).Times(1).Return(transferTx.ToAccount, nil),
+					store.EXPECT().
+						TransferTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:           "KeyTooLong",
+			idempotencyKey: util.RandomString(maxIdempotencyKeyLength + 1),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(2).Return(transferTx.FromAccount, nil)
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			b, err := json.Marshal(req)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(b))
+			require.NoError(t, err)
+
+			request.Header.Set(idempotencyKeyHeader, tc.idempotencyKey)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
 func requireBodyMatchTransferTx(t *testing.T, body *bytes.Buffer, transferTx db.TransferTxResult) {
 	data, err := ioutil.ReadAll(body)
 	require.NoError(t, err)
@@ -351,7 +743,7 @@ func randomTransferTx(t *testing.T) db.TransferTxResult {
 	}
 }
 
-func randomTransfer(fromAccountID, toAccountID int64, amount float64) db.Transfer {
+func randomTransfer(fromAccountID, toAccountID int64, amount int64) db.Transfer {
 	return db.Transfer{
 		ID:            util.RandomInt(1, 1000),
 		FromAccountID: fromAccountID,
@@ -360,7 +752,7 @@ func randomTransfer(fromAccountID, toAccountID int64, amount float64) db.Transfe
 	}
 }
 
-func randomEntry(accountID int64, amount float64) db.Entry {
+func randomEntry(accountID int64, amount int64) db.Entry {
 	return db.Entry{
 		ID:        util.RandomInt(1, 1000),
 		AccountID: accountID,
@@ -369,7 +761,7 @@ func randomEntry(accountID int64, amount float64) db.Entry {
 }
 
 func TestGetTransferAPI(t *testing.T) {
-	transfer := randomTransfer(1, 2, 100.45)
+	transfer := randomTransfer(1, 2, 10045)
 
 	owner := transfer.Sender
 
@@ -378,7 +770,7 @@ func TestGetTransferAPI(t *testing.T) {
 		transferID    int64
 		fromAccountID int64
 		toAccountID   int64
-		amount        float64
+		amount        int64
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
@@ -390,7 +782,7 @@ func TestGetTransferAPI(t *testing.T) {
 			toAccountID:   transfer.ToAccountID,
 			amount:        transfer.Amount,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -413,7 +805,7 @@ func TestGetTransferAPI(t *testing.T) {
 			toAccountID:   transfer.ToAccountID,
 			amount:        transfer.Amount,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -434,7 +826,7 @@ func TestGetTransferAPI(t *testing.T) {
 			toAccountID:   transfer.ToAccountID,
 			amount:        transfer.Amount,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -456,7 +848,7 @@ func TestGetTransferAPI(t *testing.T) {
 			toAccountID:   transfer.ToAccountID,
 			amount:        transfer.Amount,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -559,7 +951,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: 0,
 			toAccountID:   0,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfers(gomock.Any(), gomock.Eq(db.ListTransfersParams{
@@ -582,7 +974,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: fromAccountID,
 			toAccountID:   0,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByFromAccount(gomock.Any(), gomock.Eq(db.ListTransfersByFromAccountParams{
@@ -606,7 +998,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: 0,
 			toAccountID:   toAccountID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByToAccount(gomock.Any(), gomock.Eq(db.ListTransfersByToAccountParams{
@@ -630,7 +1022,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: fromAccountID,
 			toAccountID:   toAccountID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByFromAndToAccount(gomock.Any(), gomock.Eq(db.ListTransfersByFromAndToAccountParams{
@@ -655,7 +1047,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: fromAccountID,
 			toAccountID:   toAccountID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByFromAndToAccount(gomock.Any(), gomock.Eq(db.ListTransfersByFromAndToAccountParams{
@@ -678,7 +1070,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: fromAccountID,
 			toAccountID:   toAccountID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByFromAndToAccount(gomock.Any(), gomock.Eq(db.ListTransfersByFromAndToAccountParams{
@@ -702,7 +1094,7 @@ func TestListTransfersApI(t *testing.T) {
 			fromAccountID: fromAccountID,
 			toAccountID:   toAccountID,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListTransfersByFromAndToAccount(gomock.Any(), gomock.Eq(db.ListTransfersByFromAndToAccountParams{
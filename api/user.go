@@ -1,13 +1,16 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type createUserRequest struct {
@@ -21,6 +24,8 @@ type userResponse struct {
 	Username          string    `json:"username"`
 	FullName          string    `json:"fullName"`
 	Email             string    `json:"email"`
+	Role              string    `json:"role"`
+	IsFrozen          bool      `json:"isFrozen"`
 	PasswordChangedAt time.Time `json:"passwordChangedAt"`
 	CreatedAt         time.Time `json:"createdAt"`
 }
@@ -65,6 +70,8 @@ func newUserResponse(user db.User) userResponse {
 		Username:          user.Username,
 		Email:             user.Email,
 		FullName:          user.FullName,
+		Role:              user.Role,
+		IsFrozen:          user.IsFrozen,
 		PasswordChangedAt: user.PasswordChangedAt,
 		CreatedAt:         user.CreatedAt,
 	}
@@ -97,6 +104,154 @@ func (server *Server) getUser(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, rsp)
 }
 
+type updateUserRoleURIRequest struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+type updateUserRoleBodyRequest struct {
+	Role string `json:"role" binding:"required,role"`
+}
+
+// updateUserRole changes the role of a user. Admins cannot change their own role,
+// which keeps the last admin from locking everyone out of role management.
+func (server *Server) updateUserRole(ctx *gin.Context) {
+	var uriReq updateUserRoleURIRequest
+	if err := ctx.ShouldBindUri(&uriReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var bodyReq updateUserRoleBodyRequest
+	if err := ctx.ShouldBindJSON(&bodyReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if uriReq.Username == authPayload.Username {
+		err := errors.New("cannot change own role")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	if uriReq.Username == db.SystemUsername {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(db.ErrSystemUser))
+		return
+	}
+
+	user, err := server.store.UpdateUserRoleTx(ctx, db.UpdateUserRoleParams{
+		Username: uriReq.Username,
+		Role:     bodyReq.Role,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := newUserResponse(user)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type freezeUserRequest struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+// freezeUser freezes a user, which logs them out of every session and refuses their logins and API keys
+func (server *Server) freezeUser(ctx *gin.Context) {
+	var req freezeUserRequest
+	if !server.authorizeUserFreeze(ctx, &req) {
+		return
+	}
+
+	result, err := server.store.FreezeUserTx(ctx, req.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrSystemUser) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	rsp := newUserResponse(result.User)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// unfreezeUser lets a frozen user log in again. The sessions blocked by the freeze stay blocked.
+func (server *Server) unfreezeUser(ctx *gin.Context) {
+	var req freezeUserRequest
+	if !server.authorizeUserFreeze(ctx, &req) {
+		return
+	}
+
+	user, err := server.store.UpdateUserFrozen(ctx, db.UpdateUserFrozenParams{
+		Username: req.Username,
+		IsFrozen: false,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := newUserResponse(user)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// authorizeUserFreeze binds the request and checks that the caller may freeze or unfreeze the user.
+// Bankers may only freeze depositors, and nobody may freeze themselves or the system user.
+func (server *Server) authorizeUserFreeze(ctx *gin.Context, req *freezeUserRequest) bool {
+	if err := ctx.ShouldBindUri(req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if req.Username == authPayload.Username {
+		err := errors.New("cannot freeze or unfreeze yourself")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	if req.Username == db.SystemUsername {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(db.ErrSystemUser))
+		return false
+	}
+
+	user, err := server.store.GetUser(ctx, req.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if user.Role != util.DepositorRole && authPayload.Role != util.AdminRole {
+		err := errors.New("only admins can freeze or unfreeze staff")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+var errInvalidCredentials = errors.New("invalid username or password")
+
 type loginUserRequest struct {
 	Username string `json:"username" binding:"required,alphanum"`
 	Password string `json:"password" binding:"required,min=6"`
@@ -119,10 +274,21 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	guard, valid := server.guardLogin(ctx, req.Username)
+	if !valid {
+		return
+	}
+
+	if !guard.delay(ctx) {
+		return
+	}
+
+	// unknown users and wrong passwords get the same response, so that usernames can't be probed
 	user, err := server.store.GetUser(ctx, req.Username)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+			guard.reject(ctx, errInvalidCredentials)
 			return
 		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -131,13 +297,38 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		guard.reject(ctx, errInvalidCredentials)
+		return
+	}
+
+	// a frozen user gets the same response as a wrong password, so that it doesn't tell whether the password was right
+	if user.IsFrozen {
+		guard.reject(ctx, errInvalidCredentials)
+		return
+	}
+
+	// the two-step login is only served by the gRPC gateway, so users with two-factor authentication
+	// can't get a session here. They get the same response as a wrong password, so that it doesn't tell
+	// whether the password was right, and the attempt counts as failed.
+	credential, err := server.store.GetTotpCredential(ctx, user.Username)
+	if err != nil && err != db.ErrRecordNotFound {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == nil && credential.IsEnabled {
+		guard.reject(ctx, errInvalidCredentials)
+		return
+	}
+
+	if !guard.succeed(ctx) {
 		return
 	}
 
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.AccessTokenDuration,
+		token.TokenTypeAccessToken,
 	)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -146,7 +337,9 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.RefreshTokenDuration,
+		token.TokenTypeRefreshToken,
 	)
 
 	if err != nil {
@@ -155,13 +348,16 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
-		ID:           refreshPayload.ID,
-		Username:     user.Username,
-		RefreshToken: refreshToken,
-		UserAgent:    ctx.Request.UserAgent(),
-		ClientIp:     ctx.ClientIP(),
-		IsBlocked:    false,
-		ExpiresAt:    refreshPayload.ExpiredAt,
+		ID:                   refreshPayload.ID,
+		FamilyID:             refreshPayload.ID,
+		Username:             user.Username,
+		RefreshToken:         refreshToken,
+		UserAgent:            ctx.Request.UserAgent(),
+		ClientIp:             ctx.ClientIP(),
+		IsBlocked:            false,
+		ExpiresAt:            refreshPayload.ExpiredAt,
+		AccessTokenID:        accessPayload.ID,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
 	})
 
 	if err != nil {
@@ -208,6 +208,7 @@ func randomUser(t *testing.T, password string) db.User {
 		Email:             util.RandomEmail(),
 		FullName:          util.RandomString(8),
 		HashedPassword:    hash,
+		Role:              util.DepositorRole,
 		PasswordChangedAt: time.Time{},
 		CreatedAt:         time.Time{},
 	}
@@ -313,14 +314,47 @@ func requireBodyMatchUser(t *testing.T, body *bytes.Buffer, user db.User) {
 	require.WithinDuration(t, user.CreatedAt, gotUser.CreatedAt, time.Second)
 }
 
+// expectLoginGuard stubs the lockout checks done before a login attempt
+func expectLoginGuard(store *mockdb.MockStore) {
+	store.EXPECT().GetLatestLoginLockout(gomock.Any(), gomock.Any()).Times(2).Return(db.LoginLockout{}, db.ErrRecordNotFound)
+	store.EXPECT().
+		CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(int64(0), nil)
+}
+
+// expectLoginAttempt stubs the recording of a login attempt
+func expectLoginAttempt(t *testing.T, store *mockdb.MockStore, username string, isSuccessful bool) {
+	store.EXPECT().
+		CreateLoginAttempt(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+			require.Equal(t, username, arg.Username)
+			require.Equal(t, testLoginClientIP, arg.ClientIp)
+			require.Equal(t, isSuccessful, arg.IsSuccessful)
+			return db.LoginAttempt{Username: arg.Username, ClientIp: arg.ClientIp, IsSuccessful: arg.IsSuccessful}, nil
+		})
+}
+
+// expectLoginFailure stubs the recording of a failed login that does not reach any lockout threshold
+func expectLoginFailure(t *testing.T, store *mockdb.MockStore, username string) {
+	expectLoginAttempt(t, store, username, false)
+	store.EXPECT().CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+	store.EXPECT().CountLoginFailuresByClientIp(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+	store.EXPECT().CreateLoginLockout(gomock.Any(), gomock.Any()).Times(0)
+}
+
+// testLoginClientIP is the address login requests come from in the tests
+const testLoginClientIP = "198.51.100.1"
+
 func TestLoginUserAPI(t *testing.T) {
-	maker, err := token.NewPasetoMaker(tokenSymmetricKey)
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
 	require.NoError(t, err)
 
 	password := util.RandomString(8)
 	user := randomUser(t, password)
 
-	accessToken, payload, err := maker.CreateToken(user.Username, accessTokenDuration)
+	accessToken, payload, err := maker.CreateToken(user.Username, user.Role, accessTokenDuration, token.TokenTypeAccessToken)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -332,6 +366,7 @@ func TestLoginUserAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		body          gin.H
+		forwardedFor  string
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
@@ -342,10 +377,16 @@ func TestLoginUserAPI(t *testing.T) {
 				"password": password,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(store)
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				expectLoginAttempt(t, store, user.Username, true)
 				store.EXPECT().
 					CreateSession(gomock.Any(), gomock.Any()).
 					Times(1)
@@ -355,6 +396,57 @@ func TestLoginUserAPI(t *testing.T) {
 				requireBodyMatchLoginResponse(t, recorder.Body, rsp)
 			},
 		},
+		{
+			name: "TwoFactorEnabled",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(store)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{Username: user.Username, IsEnabled: true}, nil)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// the same response as a wrong password, so the password isn't confirmed
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Contains(t, recorder.Body.String(), errInvalidCredentials.Error())
+			},
+		},
+		{
+			name: "FrozenUser",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				frozenUser := user
+				frozenUser.IsFrozen = true
+				expectLoginGuard(store)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(frozenUser, nil)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Contains(t, recorder.Body.String(), errInvalidCredentials.Error())
+			},
+		},
 		{
 			name: "BadRequest",
 			body: gin.H{
@@ -371,19 +463,22 @@ func TestLoginUserAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "NotFound",
+			name: "UserNotFound",
 			body: gin.H{
 				"username": user.Username,
 				"password": password,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(store)
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
 					Return(db.User{}, db.ErrRecordNotFound)
+				expectLoginFailure(t, store, user.Username)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Contains(t, recorder.Body.String(), errInvalidCredentials.Error())
 			},
 		},
 		{
@@ -393,6 +488,7 @@ func TestLoginUserAPI(t *testing.T) {
 				"password": password,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(store)
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
@@ -409,13 +505,66 @@ func TestLoginUserAPI(t *testing.T) {
 				"password": util.RandomString(8),
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(store)
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
 					Return(user, nil)
+				expectLoginFailure(t, store, user.Username)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Contains(t, recorder.Body.String(), errInvalidCredentials.Error())
+			},
+		},
+		{
+			name: "UsernameLockedOut",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+						SubjectType: lockoutSubjectUsername,
+						Subject:     user.Username,
+					})).
+					Times(1).
+					Return(db.LoginLockout{LockedUntil: time.Now().Add(time.Minute)}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateLoginAttempt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "SpoofedForwardedFor",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			forwardedFor: "203.0.113.7",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+						SubjectType: lockoutSubjectUsername,
+						Subject:     user.Username,
+					})).
+					Times(1).
+					Return(db.LoginLockout{}, db.ErrRecordNotFound)
+				// the lockout of the connection's address holds whatever the client forwards
+				store.EXPECT().
+					GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+						SubjectType: lockoutSubjectClientIP,
+						Subject:     testLoginClientIP,
+					})).
+					Times(1).
+					Return(db.LoginLockout{LockedUntil: time.Now().Add(time.Minute)}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
 			},
 		},
 	}
@@ -423,12 +572,16 @@ func TestLoginUserAPI(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			ctrl.Finish()
+			defer ctrl.Finish()
 
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
 			server := newTestServer(t, store)
+			server.config.LoginFailureWindow = 15 * time.Minute
+			server.config.LoginLockoutThreshold = 5
+			server.config.LoginIPLockoutThreshold = 20
+			server.config.LoginLockoutDuration = 15 * time.Minute
 			recorder := httptest.NewRecorder()
 
 			url := "/users/login"
@@ -440,7 +593,130 @@ func TestLoginUserAPI(t *testing.T) {
 
 			request, err := http.NewRequest(http.MethodPost, url, body)
 			require.NoError(t, err)
+			request.RemoteAddr = testLoginClientIP + ":54321"
+			if tc.forwardedFor != "" {
+				request.Header.Set("X-Forwarded-For", tc.forwardedFor)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestFreezeUserAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	staff := randomUser(t, util.RandomString(6))
+	staff.Role = util.BankerRole
+	banker := util.RandomOwner()
+
+	testCases := []struct {
+		name          string
+		username      string
+		callerRole    string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			username:   user.Username,
+			callerRole: util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				frozenUser := user
+				frozenUser.IsFrozen = true
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.FreezeUserTxResult{User: frozenUser}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotUser userResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotUser)
+				require.NoError(t, err)
+				require.Equal(t, user.Username, gotUser.Username)
+				require.True(t, gotUser.IsFrozen)
+			},
+		},
+		{
+			name:       "BankerFreezesStaff",
+			username:   staff.Username,
+			callerRole: util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(staff.Username)).
+					Times(1).
+					Return(staff, nil)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "Self",
+			username:   banker,
+			callerRole: util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "SystemUser",
+			username:   db.SystemUsername,
+			callerRole: util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:       "DepositorDenied",
+			username:   user.Username,
+			callerRole: util.DepositorRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/users/%s/freeze", tc.username)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
 
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, banker, tc.callerRole, time.Minute)
 			server.router.ServeHTTP(recorder, request)
 			tc.checkResponse(t, recorder)
 		})
@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/go-playground/validator/v10"
+	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/util"
 )
 
@@ -11,3 +12,31 @@ var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var validRole validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if role, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedRole(role)
+	}
+	return false
+}
+
+var validScope validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if scope, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedScope(scope)
+	}
+	return false
+}
+
+var validFrequency validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if frequency, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedFrequency(frequency)
+	}
+	return false
+}
+
+var validTransferType validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if transferType, ok := fieldLevel.Field().Interface().(string); ok {
+		return db.IsSupportedTransferType(transferType)
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+ALTER TABLE "transfers" ALTER COLUMN "amount" TYPE float USING "amount" / 100.0;
+
+ALTER TABLE "entries" ALTER COLUMN "amount" TYPE float USING "amount" / 100.0;
+
+ALTER TABLE "accounts" ALTER COLUMN "balance" TYPE float USING "balance" / 100.0;
+
+COMMENT ON COLUMN "accounts"."balance" IS NULL;
+
+COMMENT ON COLUMN "entries"."amount" IS 'can be positive or negative';
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
@@ -0,0 +1,13 @@
+-- Monetary values are stored as integer minor units (e.g. cents).
+-- Every supported currency currently has an exponent of 2, see util/currency.go.
+ALTER TABLE "accounts" ALTER COLUMN "balance" TYPE bigint USING round("balance" * 100)::bigint;
+
+ALTER TABLE "entries" ALTER COLUMN "amount" TYPE bigint USING round("amount" * 100)::bigint;
+
+ALTER TABLE "transfers" ALTER COLUMN "amount" TYPE bigint USING round("amount" * 100)::bigint;
+
+COMMENT ON COLUMN "accounts"."balance" IS 'in minor units of the account currency';
+
+COMMENT ON COLUMN "entries"."amount" IS 'can be positive or negative, in minor units';
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive, in minor units';
@@ -0,0 +1,12 @@
+CREATE TABLE "idempotency_keys" (
+  "owner" varchar NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "response" jsonb,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("owner", "idempotency_key")
+);
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "idempotency_keys"."response" IS 'stored result returned on replay';
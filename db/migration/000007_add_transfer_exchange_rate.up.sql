@@ -0,0 +1,13 @@
+ALTER TABLE "transfers" ADD COLUMN "to_amount" bigint;
+
+UPDATE "transfers" SET "to_amount" = "amount";
+
+ALTER TABLE "transfers" ALTER COLUMN "to_amount" SET NOT NULL;
+
+ALTER TABLE "transfers" ADD COLUMN "exchange_rate" numeric NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive, debited in minor units of the source account currency';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'credited in minor units of the destination account currency';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'units of the destination currency bought by one unit of the source currency';
@@ -0,0 +1,26 @@
+CREATE TABLE "login_attempts" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "is_successful" bool NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "login_lockouts" (
+  "id" bigserial PRIMARY KEY,
+  "subject_type" varchar NOT NULL,
+  "subject" varchar NOT NULL,
+  "failed_attempts" bigint NOT NULL,
+  "locked_until" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "login_attempts" ("username", "created_at");
+
+CREATE INDEX ON "login_attempts" ("client_ip", "created_at");
+
+CREATE INDEX ON "login_lockouts" ("subject_type", "subject", "created_at");
+
+COMMENT ON COLUMN "login_attempts"."username" IS 'as sent by the client, the user may not exist';
+
+COMMENT ON COLUMN "login_lockouts"."subject_type" IS 'username or client_ip';
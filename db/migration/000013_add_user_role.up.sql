@@ -0,0 +1,6 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'depositor';
+
+ALTER TABLE "users" ADD CONSTRAINT "role_supported" CHECK ("role" IN ('depositor', 'banker', 'admin'));
+
+-- staff freeze users, who then can't log in or use their API keys until they are unfrozen
+ALTER TABLE "users" ADD COLUMN "is_frozen" bool NOT NULL DEFAULT false;
@@ -0,0 +1,18 @@
+ALTER TABLE "sessions" ADD COLUMN "access_token_id" uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+ALTER TABLE "sessions" ADD COLUMN "access_token_expires_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z';
+
+CREATE TABLE "revoked_tokens" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "revoked_tokens" ("expires_at");
+
+COMMENT ON COLUMN "sessions"."access_token_id" IS 'the access token issued with the refresh token of the session';
+
+COMMENT ON COLUMN "revoked_tokens"."id" IS 'id of the revoked access token';
+
+ALTER TABLE "revoked_tokens" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
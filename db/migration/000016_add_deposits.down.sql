@@ -0,0 +1,9 @@
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "description";
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "reference";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'system');
+
+DELETE FROM "accounts" WHERE "owner" = 'system';
+
+DELETE FROM "users" WHERE "username" = 'system';
@@ -0,0 +1,18 @@
+-- The system user owns the accounts of the bank itself.
+-- Its password hash is empty, so nobody can ever log in as it.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('system', '', 'Simple Bank', 'system@simplebank.invalid');
+
+-- One settlement account per supported currency, see util/currency.go,
+-- is the counterpart of every deposit and withdrawal in that currency
+INSERT INTO "accounts" ("owner", "balance", "currency")
+SELECT 'system', 0, "currency"
+FROM unnest(ARRAY['NGN', 'RUB', 'CNY', 'USD', 'GBP', 'EUR', 'CAD']) AS "currency";
+
+ALTER TABLE "entries" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX ON "entries" ("account_id", "reference") WHERE "reference" <> '';
+
+COMMENT ON COLUMN "entries"."reference" IS 'external reference of a deposit or withdrawal, posted at most once per account';
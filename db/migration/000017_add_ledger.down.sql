@@ -0,0 +1,18 @@
+DELETE FROM "entries" WHERE "description" = 'legacy balance adjustment'
+AND "journal_id" IN (SELECT "id" FROM "journals" WHERE "kind" = 'legacy');
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" IN ('fees', 'fx'));
+
+DELETE FROM "accounts" WHERE "kind" IN ('fees', 'fx');
+
+ALTER TABLE "accounts" DROP CONSTRAINT "owner_currency_kind_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", "currency");
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "kind";
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "journal_id";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "journal_id";
+
+DROP TABLE IF EXISTS "journals";
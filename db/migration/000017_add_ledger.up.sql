@@ -0,0 +1,87 @@
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "journals"."kind" IS 'transfer, deposit, withdrawal or legacy';
+
+-- Every transfer so far becomes a journal of its own, with the same id.
+-- Transfers between currencies have no FX postings, so their debit and credit can never balance
+-- in a currency, and they are kept as legacy journals.
+INSERT INTO "journals" ("id", "kind", "created_at")
+SELECT "transfers"."id",
+  CASE WHEN "from_accounts"."currency" = "to_accounts"."currency" THEN 'transfer' ELSE 'legacy' END,
+  "transfers"."created_at"
+FROM "transfers"
+JOIN "accounts" AS "from_accounts" ON "from_accounts"."id" = "transfers"."from_account_id"
+JOIN "accounts" AS "to_accounts" ON "to_accounts"."id" = "transfers"."to_account_id";
+
+SELECT setval(pg_get_serial_sequence('journals', 'id'), max("id")) FROM "journals";
+
+ALTER TABLE "transfers" ADD COLUMN "journal_id" bigint;
+
+UPDATE "transfers" SET "journal_id" = "id";
+
+ALTER TABLE "entries" ADD COLUMN "journal_id" bigint;
+
+-- The entries of a transfer were created in its transaction, so they share its created_at
+UPDATE "entries" SET "journal_id" = "transfers"."id"
+FROM "transfers"
+WHERE "entries"."created_at" = "transfers"."created_at"
+AND (("entries"."account_id" = "transfers"."from_account_id" AND "entries"."amount" = -"transfers"."amount")
+  OR ("entries"."account_id" = "transfers"."to_account_id" AND "entries"."amount" = "transfers"."to_amount"));
+
+-- Entries that no transfer explains, e.g. deposits and withdrawals, are kept in one legacy journal,
+-- along with the balances that no entry explains
+INSERT INTO "journals" ("kind")
+SELECT 'legacy'
+WHERE EXISTS (SELECT 1 FROM "entries" WHERE "journal_id" IS NULL)
+OR EXISTS (
+  SELECT 1 FROM "accounts"
+  WHERE "balance" <> (SELECT COALESCE(sum("amount"), 0) FROM "entries" WHERE "account_id" = "accounts"."id")
+);
+
+UPDATE "entries" SET "journal_id" = (SELECT max("id") FROM "journals" WHERE "kind" = 'legacy')
+WHERE "journal_id" IS NULL;
+
+-- Every account whose balance is not the sum of its entries, e.g. one opened with a balance,
+-- gets one adjustment entry for the difference, so that its balance and statements reconcile
+INSERT INTO "entries" ("account_id", "amount", "description", "journal_id")
+SELECT "accounts"."id", "accounts"."balance" - COALESCE(sum("entries"."amount"), 0), 'legacy balance adjustment',
+  (SELECT max("id") FROM "journals" WHERE "kind" = 'legacy')
+FROM "accounts"
+LEFT JOIN "entries" ON "entries"."account_id" = "accounts"."id"
+GROUP BY "accounts"."id"
+HAVING "accounts"."balance" <> COALESCE(sum("entries"."amount"), 0);
+
+ALTER TABLE "transfers" ALTER COLUMN "journal_id" SET NOT NULL;
+
+ALTER TABLE "entries" ALTER COLUMN "journal_id" SET NOT NULL;
+
+CREATE UNIQUE INDEX ON "transfers" ("journal_id");
+
+CREATE INDEX ON "entries" ("journal_id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+-- System accounts are owned by the system user, one of each kind per currency
+ALTER TABLE "accounts" ADD COLUMN "kind" varchar NOT NULL DEFAULT 'customer';
+
+ALTER TABLE "accounts" ADD CONSTRAINT "kind_supported" CHECK ("kind" IN ('customer', 'cash', 'fees', 'fx'));
+
+ALTER TABLE "accounts" DROP CONSTRAINT "owner_currency_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owner_currency_kind_key" UNIQUE ("owner", "currency", "kind");
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the cash, fees or fx system account of the currency';
+
+-- The settlement accounts of deposits and withdrawals are the cash accounts
+UPDATE "accounts" SET "kind" = 'cash' WHERE "owner" = 'system';
+
+INSERT INTO "accounts" ("owner", "balance", "currency", "kind")
+SELECT 'system', 0, "currency", "kind"
+FROM unnest(ARRAY['NGN', 'RUB', 'CNY', 'USD', 'GBP', 'EUR', 'CAD']) AS "currency",
+unnest(ARRAY['fees', 'fx']) AS "kind";
@@ -0,0 +1,40 @@
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "frequency" varchar NOT NULL,
+  "day_of_month" int NOT NULL DEFAULT 0,
+  "next_run_at" timestamptz NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "failed_attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "last_transfer_id" bigint NOT NULL DEFAULT 0,
+  "last_run_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "claimed_until" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "amount_positive" CHECK ("amount" > 0),
+  CONSTRAINT "frequency_supported" CHECK ("frequency" IN ('once', 'weekly', 'monthly')),
+  CONSTRAINT "status_supported" CHECK ("status" IN ('active', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("next_run_at") WHERE "status" = 'active';
+
+COMMENT ON COLUMN "scheduled_transfers"."amount" IS 'in minor units of the currency both accounts share';
+
+COMMENT ON COLUMN "scheduled_transfers"."day_of_month" IS 'day a monthly transfer runs on, the last day of shorter months';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'when the next run is due';
+
+COMMENT ON COLUMN "scheduled_transfers"."failed_attempts" IS 'failed attempts of the next run';
+
+COMMENT ON COLUMN "scheduled_transfers"."claimed_until" IS 'skipped until then, while a worker runs it or before a retry';
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
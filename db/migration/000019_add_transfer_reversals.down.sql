@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS "transfer_reversals";
+
+ALTER TABLE "transfers" DROP CONSTRAINT IF EXISTS "refunded_amount_within_to_amount";
+
+ALTER TABLE "transfers" DROP CONSTRAINT IF EXISTS "status_supported";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "refunded_amount";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "status";
+
+COMMENT ON COLUMN "journals"."kind" IS 'transfer, deposit, withdrawal or legacy';
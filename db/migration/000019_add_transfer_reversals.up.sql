@@ -0,0 +1,31 @@
+ALTER TABLE "transfers" ADD COLUMN "status" varchar NOT NULL DEFAULT 'original';
+
+ALTER TABLE "transfers" ADD COLUMN "refunded_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD CONSTRAINT "status_supported" CHECK ("status" IN ('original', 'reversed', 'partially_refunded'));
+
+ALTER TABLE "transfers" ADD CONSTRAINT "refunded_amount_within_to_amount" CHECK ("refunded_amount" BETWEEN 0 AND "to_amount");
+
+CREATE TABLE "transfer_reversals" (
+  "transfer_id" bigint PRIMARY KEY,
+  "original_transfer_id" bigint NOT NULL,
+  "reason" varchar NOT NULL DEFAULT '',
+  "reversed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfer_reversals" ("original_transfer_id");
+
+COMMENT ON COLUMN "transfers"."status" IS 'original, reversed or partially_refunded';
+
+COMMENT ON COLUMN "transfers"."refunded_amount" IS 'sent back by reversals, in minor units of the destination account currency';
+
+COMMENT ON COLUMN "transfer_reversals"."transfer_id" IS 'the compensating transfer, from the recipient back to the sender';
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("original_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("reversed_by") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "journals"."kind" IS 'transfer, reversal, deposit, withdrawal or legacy';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "holds";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "available_balance";
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "held_balance_not_negative";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "held_balance";
@@ -0,0 +1,47 @@
+ALTER TABLE "accounts" ADD COLUMN "held_balance" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "held_balance_not_negative" CHECK ("held_balance" >= 0);
+
+ALTER TABLE "accounts" ADD COLUMN "available_balance" bigint NOT NULL GENERATED ALWAYS AS ("balance" - "held_balance") STORED;
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "sender" varchar NOT NULL,
+  "recipient" varchar NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'authorized',
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "closed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "amount_positive" CHECK ("amount" > 0),
+  CONSTRAINT "status_supported" CHECK ("status" IN ('authorized', 'captured', 'voided', 'expired')),
+  CONSTRAINT "captured_amount_within_amount" CHECK ("captured_amount" BETWEEN 0 AND "amount")
+);
+
+CREATE INDEX ON "holds" ("account_id");
+
+CREATE INDEX ON "holds" ("expires_at") WHERE "status" = 'authorized';
+
+COMMENT ON COLUMN "accounts"."held_balance" IS 'reserved by authorized holds, in minor units of the account currency';
+
+COMMENT ON COLUMN "accounts"."available_balance" IS 'balance less held_balance, what transfers and withdrawals may spend';
+
+COMMENT ON COLUMN "holds"."account_id" IS 'the account the money is held on';
+
+COMMENT ON COLUMN "holds"."to_account_id" IS 'the account a capture pays into';
+
+COMMENT ON COLUMN "holds"."amount" IS 'in minor units of the currency both accounts share';
+
+COMMENT ON COLUMN "holds"."transfer_id" IS 'the transfer of the capture, 0 until captured';
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("sender") REFERENCES "users" ("username");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("recipient") REFERENCES "users" ("username");
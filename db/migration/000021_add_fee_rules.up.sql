@@ -0,0 +1,33 @@
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar NOT NULL,
+  "transfer_type" varchar NOT NULL,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "basis_points" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "transfer_type_supported" CHECK ("transfer_type" IN ('internal', 'cross_currency', 'scheduled')),
+  CONSTRAINT "min_amount_not_negative" CHECK ("min_amount" >= 0),
+  CONSTRAINT "flat_fee_not_negative" CHECK ("flat_fee" >= 0),
+  CONSTRAINT "basis_points_within_100_percent" CHECK ("basis_points" BETWEEN 0 AND 10000),
+  CONSTRAINT "max_fee_not_negative" CHECK ("max_fee" >= 0)
+);
+
+CREATE UNIQUE INDEX ON "fee_rules" ("currency", "transfer_type", "min_amount");
+
+ALTER TABLE "transfers" ADD COLUMN "fee" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON TABLE "fee_rules" IS 'a transfer is priced by the rule of its currency and type with the highest min_amount not above its amount';
+
+COMMENT ON COLUMN "fee_rules"."currency" IS 'of the source account, which pays the fee';
+
+COMMENT ON COLUMN "fee_rules"."transfer_type" IS 'internal, cross_currency or scheduled';
+
+COMMENT ON COLUMN "fee_rules"."min_amount" IS 'lower bound of the tier, in minor units';
+
+COMMENT ON COLUMN "fee_rules"."basis_points" IS 'percentage of the amount, in hundredths of a percent';
+
+COMMENT ON COLUMN "fee_rules"."max_fee" IS 'cap of the fee, 0 for none';
+
+COMMENT ON COLUMN "transfers"."fee" IS 'charged to the source account on top of the amount, in minor units of its currency';
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_idx";
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "transfer_limits_not_negative";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "monthly_transfer_limit";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "daily_transfer_limit";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "max_transfer_amount";
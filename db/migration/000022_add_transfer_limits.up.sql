@@ -0,0 +1,15 @@
+ALTER TABLE "accounts" ADD COLUMN "max_transfer_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD COLUMN "daily_transfer_limit" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD COLUMN "monthly_transfer_limit" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "transfer_limits_not_negative" CHECK ("max_transfer_amount" >= 0 AND "daily_transfer_limit" >= 0 AND "monthly_transfer_limit" >= 0);
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+COMMENT ON COLUMN "accounts"."max_transfer_amount" IS 'overrides the default limit of the currency, 0 to keep it';
+
+COMMENT ON COLUMN "accounts"."daily_transfer_limit" IS 'overrides the default limit of the currency, 0 to keep it';
+
+COMMENT ON COLUMN "accounts"."monthly_transfer_limit" IS 'overrides the default limit of the currency, 0 to keep it';
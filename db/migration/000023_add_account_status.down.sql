@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "account_status_changes";
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "status_supported";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "status";
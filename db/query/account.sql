@@ -16,6 +16,13 @@ SELECT * FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE;
 
+-- name: GetSystemAccount :one
+SELECT * FROM accounts
+WHERE owner = 'system'
+AND kind = sqlc.arg(kind)
+AND currency = sqlc.arg(currency)
+LIMIT 1;
+
 -- name: ListAccounts :many
 SELECT * FROM accounts
 WHERE id > $1
@@ -23,20 +30,35 @@ AND owner = $2
 ORDER BY id
 LIMIT $3;
 
--- name: UpdateAccount :one
+-- name: UpdateAccountBalance :one
 UPDATE accounts
-SET balance = $2
-WHERE id = $1
-AND owner = $3
+SET balance = balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: UpdateAccountBalance :one
+-- name: UpdateAccountOverdraftLimit :one
 UPDATE accounts
-SET balance = balance + sqlc.arg(amount)
+SET overdraft_limit = sqlc.arg(overdraft_limit)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateAccountHeldBalance :one
+UPDATE accounts
+SET held_balance = held_balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1
-AND owner = $2;
\ No newline at end of file
+-- name: UpdateAccountTransferLimits :one
+UPDATE accounts
+SET
+  max_transfer_amount = sqlc.arg(max_transfer_amount),
+  daily_transfer_limit = sqlc.arg(daily_transfer_limit),
+  monthly_transfer_limit = sqlc.arg(monthly_transfer_limit)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
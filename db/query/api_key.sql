@@ -0,0 +1,35 @@
+-- name: CreateApiKey :one
+INSERT INTO api_keys (
+  id,
+  username,
+  name,
+  hashed_secret,
+  scopes,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetApiKeyWithRole :one
+SELECT sqlc.embed(api_keys), users.role, users.is_frozen
+FROM api_keys
+JOIN users ON users.username = api_keys.username
+WHERE api_keys.id = $1 LIMIT 1;
+
+-- name: ListApiKeys :many
+SELECT * FROM api_keys
+WHERE username = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeApiKey :one
+UPDATE api_keys
+SET is_revoked = true
+WHERE id = sqlc.arg(id)
+AND username = sqlc.arg(username)
+RETURNING *;
+
+-- name: UpdateApiKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE id = $1
+AND last_used_at < now() - interval '1 minute';
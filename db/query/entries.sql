@@ -1,9 +1,12 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
+  journal_id,
   account_id,
-  amount
+  amount,
+  reference,
+  description
 ) VALUES (
-  $1, $2
+  $1, $2, $3, $4, $5
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -22,3 +25,24 @@ WHERE account_id = $1
 AND id > $2
 ORDER BY id
 LIMIT $3;
+
+-- name: GetBalanceBefore :one
+SELECT COALESCE(sum(amount), 0)::bigint AS balance
+FROM entries
+WHERE account_id = sqlc.arg(account_id)
+AND created_at < sqlc.arg(before_time);
+
+-- name: ListStatementEntries :many
+SELECT sqlc.embed(entries), journals.kind AS journal_kind,
+  COALESCE(transfers.id, 0)::bigint AS transfer_id,
+  (CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id
+    ELSE COALESCE(transfers.from_account_id, 0) END)::bigint AS counterparty_account_id,
+  (CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.recipient
+    ELSE COALESCE(transfers.sender, '') END)::varchar AS counterparty
+FROM entries
+JOIN journals ON journals.id = entries.journal_id
+LEFT JOIN transfers ON transfers.journal_id = entries.journal_id
+WHERE entries.account_id = sqlc.arg(account_id)
+AND entries.created_at >= sqlc.arg(from_time)
+AND entries.created_at < sqlc.arg(to_time)
+ORDER BY entries.created_at, entries.id;
@@ -0,0 +1,30 @@
+-- name: CreateFeeRule :one
+INSERT INTO fee_rules (
+  currency,
+  transfer_type,
+  min_amount,
+  flat_fee,
+  basis_points,
+  max_fee
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetFeeRule :one
+SELECT * FROM fee_rules
+WHERE currency = $1
+AND transfer_type = $2
+AND min_amount <= sqlc.arg(amount)
+ORDER BY min_amount DESC
+LIMIT 1;
+
+-- name: ListFeeRules :many
+SELECT * FROM fee_rules
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: DeleteFeeRule :one
+DELETE FROM fee_rules
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,37 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+  account_id,
+  to_account_id,
+  sender,
+  recipient,
+  amount,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: CloseHold :one
+UPDATE holds
+SET
+  status = sqlc.arg(status),
+  captured_amount = sqlc.arg(captured_amount),
+  transfer_id = sqlc.arg(transfer_id),
+  closed_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListExpiredHolds :many
+SELECT id FROM holds
+WHERE status = 'authorized'
+AND expires_at <= now()
+ORDER BY expires_at
+LIMIT $1;
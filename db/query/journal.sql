@@ -0,0 +1,34 @@
+-- name: CreateJournal :one
+INSERT INTO journals (
+  kind
+) VALUES (
+  $1
+) RETURNING *;
+
+-- name: GetJournal :one
+SELECT * FROM journals
+WHERE id = $1 LIMIT 1;
+
+-- name: ListJournalEntries :many
+SELECT * FROM entries
+WHERE journal_id = $1
+ORDER BY id;
+
+-- name: ListBalanceMismatches :many
+SELECT accounts.id, accounts.owner, accounts.currency, accounts.balance,
+  COALESCE(sum(entries.amount), 0)::bigint AS entry_sum
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+GROUP BY accounts.id
+HAVING accounts.balance <> COALESCE(sum(entries.amount), 0)
+ORDER BY accounts.id;
+
+-- name: ListUnbalancedJournals :many
+SELECT entries.journal_id, accounts.currency, sum(entries.amount)::bigint AS total
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+JOIN journals ON journals.id = entries.journal_id
+WHERE journals.kind <> 'legacy'
+GROUP BY entries.journal_id, accounts.currency
+HAVING sum(entries.amount) <> 0
+ORDER BY entries.journal_id;
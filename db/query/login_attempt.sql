@@ -0,0 +1,29 @@
+-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (
+    username,
+    client_ip,
+    is_successful
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: CountLoginFailuresByUsername :one
+SELECT count(*) FROM login_attempts AS a
+WHERE
+    a.username = @username
+    AND a.is_successful = FALSE
+    AND a.created_at > @since
+    AND NOT EXISTS (
+        SELECT 1 FROM login_attempts AS s
+        WHERE
+            s.username = a.username
+            AND s.is_successful = TRUE
+            AND s.created_at > a.created_at
+    );
+
+-- name: CountLoginFailuresByClientIp :one
+SELECT count(*) FROM login_attempts
+WHERE
+    client_ip = @client_ip
+    AND is_successful = FALSE
+    AND created_at > @since;
@@ -0,0 +1,15 @@
+-- name: CreateLoginLockout :one
+INSERT INTO login_lockouts (
+    subject_type,
+    subject,
+    failed_attempts,
+    locked_until
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetLatestLoginLockout :one
+SELECT * FROM login_lockouts
+WHERE subject_type = $1 AND subject = $2
+ORDER BY created_at DESC
+LIMIT 1;
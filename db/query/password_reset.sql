@@ -0,0 +1,18 @@
+-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    username,
+    secret_code
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: UpdatePasswordReset :one
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    id = @id
+    AND secret_code = @secret_code
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING *;
@@ -0,0 +1,21 @@
+-- name: CreateRecoveryCode :one
+INSERT INTO recovery_codes (
+    username,
+    hashed_code
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_codes
+WHERE username = $1;
+
+-- name: UseRecoveryCode :one
+UPDATE recovery_codes
+SET
+    is_used = TRUE
+WHERE
+    username = @username
+    AND hashed_code = @hashed_code
+    AND is_used = FALSE
+RETURNING *;
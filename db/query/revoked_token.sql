@@ -0,0 +1,37 @@
+-- name: RevokeFamilyAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.family_id = $1
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING *;
+
+-- name: RevokeOtherAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.username = sqlc.arg(username)
+AND sessions.family_id <> sqlc.arg(family_id)
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING *;
+
+-- name: RevokeUserAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.username = $1
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING *;
+
+-- name: IsTokenRevoked :one
+SELECT EXISTS (
+  SELECT 1 FROM revoked_tokens
+  WHERE id = $1
+);
+
+-- name: DeleteExpiredRevokedTokens :execrows
+DELETE FROM revoked_tokens
+WHERE expires_at < now();
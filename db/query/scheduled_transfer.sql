@@ -0,0 +1,75 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  owner,
+  from_account_id,
+  to_account_id,
+  amount,
+  frequency,
+  day_of_month,
+  next_run_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1
+AND owner = $2
+LIMIT 1;
+
+-- name: ListScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE id > $1
+AND owner = $2
+ORDER BY id
+LIMIT $3;
+
+-- name: UpdateScheduledTransferAmount :one
+UPDATE scheduled_transfers
+SET amount = sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+AND owner = sqlc.arg(owner)
+AND status = 'active'
+RETURNING *;
+
+-- name: CancelScheduledTransfer :one
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE id = sqlc.arg(id)
+AND owner = sqlc.arg(owner)
+AND status = 'active'
+RETURNING *;
+
+-- name: ClaimDueScheduledTransfers :many
+UPDATE scheduled_transfers
+SET claimed_until = sqlc.arg(claimed_until)
+WHERE id IN (
+  SELECT id FROM scheduled_transfers
+  WHERE status = 'active'
+  AND next_run_at <= now()
+  AND claimed_until <= now()
+  ORDER BY next_run_at
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordScheduledTransferRun :one
+UPDATE scheduled_transfers
+SET
+  next_run_at = sqlc.arg(next_run_at),
+  status = sqlc.arg(status),
+  failed_attempts = sqlc.arg(failed_attempts),
+  last_error = sqlc.arg(last_error),
+  last_transfer_id = sqlc.arg(last_transfer_id),
+  last_run_at = now(),
+  claimed_until = sqlc.arg(claimed_until)
+WHERE id = sqlc.arg(id)
+AND status = 'active'
+RETURNING *;
+
+-- name: CancelScheduledTransfersByAccount :exec
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+AND status = 'active';
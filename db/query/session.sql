@@ -1,16 +1,66 @@
 -- name: CreateSession :one
 INSERT INTO sessions (
   id,
+  family_id,
   username,
   refresh_token,
   user_agent,
   client_ip,
   is_blocked,
-  expires_at
+  expires_at,
+  access_token_id,
+  access_token_expires_at
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING *;
 
 -- name: GetSession :one
 SELECT * FROM sessions
-WHERE id = $1 LIMIT 1;
\ No newline at end of file
+WHERE id = $1 LIMIT 1;
+
+-- name: ListActiveSessions :many
+SELECT * FROM sessions
+WHERE username = $1
+AND is_blocked = false
+AND is_rotated = false
+AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = true
+WHERE id = sqlc.arg(id)
+AND username = sqlc.arg(username)
+RETURNING *;
+
+-- name: BlockOtherSessions :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE username = sqlc.arg(username)
+AND family_id <> sqlc.arg(family_id)
+AND is_blocked = false;
+
+-- name: RotateSession :one
+UPDATE sessions
+SET is_rotated = true
+WHERE id = $1
+AND is_rotated = false
+AND is_blocked = false
+RETURNING *;
+
+-- name: BlockSessionFamily :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1;
+
+-- name: BlockUserSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE username = $1
+AND is_blocked = false;
+
+-- name: UpdateSessionAccessToken :exec
+UPDATE sessions
+SET access_token_id = sqlc.arg(access_token_id),
+  access_token_expires_at = sqlc.arg(access_token_expires_at)
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,39 @@
+-- name: UpsertTotpCredential :one
+INSERT INTO totp_credentials (
+    username,
+    secret
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (username) DO UPDATE
+SET
+    secret = EXCLUDED.secret,
+    last_used_counter = 0,
+    created_at = now()
+WHERE
+    totp_credentials.is_enabled = FALSE
+RETURNING *;
+
+-- name: GetTotpCredential :one
+SELECT * FROM totp_credentials
+WHERE username = $1 LIMIT 1;
+
+-- name: EnableTotpCredential :one
+UPDATE totp_credentials
+SET
+    is_enabled = TRUE,
+    last_used_counter = @last_used_counter
+WHERE
+    username = @username
+    AND is_enabled = FALSE
+RETURNING *;
+
+-- name: UpdateTotpLastUsedCounter :one
+UPDATE totp_credentials
+SET
+    last_used_counter = @last_used_counter
+WHERE
+    username = @username
+    AND is_enabled = TRUE
+    AND last_used_counter < @last_used_counter
+RETURNING *;
@@ -4,9 +4,13 @@ INSERT INTO transfers (
   to_account_id,
   amount,
   sender,
-  recipient
+  recipient,
+  to_amount,
+  exchange_rate,
+  journal_id,
+  fee
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: GetTransfer :one
@@ -16,6 +20,10 @@ AND (sender = sqlc.arg(username)
 OR recipient = sqlc.arg(username))
 LIMIT 1;
 
+-- name: GetTransferById :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1;
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
 WHERE id > $1
@@ -47,3 +55,38 @@ AND id > $3
 AND sender = $4
 ORDER BY id
 LIMIT $5;
+
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateTransferRefund :one
+UPDATE transfers
+SET
+  refunded_amount = sqlc.arg(refunded_amount),
+  status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: SumOutgoingTransfersByAccount :one
+SELECT COALESCE(SUM(amount), 0)::bigint FROM transfers
+WHERE transfers.from_account_id = $1
+AND transfers.created_at >= sqlc.arg(since)
+AND NOT EXISTS (
+  SELECT 1 FROM transfer_reversals
+  WHERE transfer_reversals.transfer_id = transfers.id
+);
+
+-- name: SumOutgoingTransfersByOwner :many
+SELECT accounts.currency, COALESCE(SUM(transfers.amount), 0)::bigint AS total FROM transfers
+JOIN accounts ON accounts.id = transfers.from_account_id
+WHERE accounts.owner = sqlc.arg(owner)
+AND accounts.kind = 'customer'
+AND transfers.created_at >= sqlc.arg(since)
+AND NOT EXISTS (
+  SELECT 1 FROM transfer_reversals
+  WHERE transfer_reversals.transfer_id = transfers.id
+)
+GROUP BY accounts.currency
+ORDER BY accounts.currency;
@@ -0,0 +1,19 @@
+-- name: CreateTransferReversal :one
+INSERT INTO transfer_reversals (
+  transfer_id,
+  original_transfer_id,
+  reason,
+  reversed_by
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetTransferReversal :one
+SELECT * FROM transfer_reversals
+WHERE transfer_id = $1 LIMIT 1;
+
+-- name: GetReturnedAmount :one
+SELECT COALESCE(SUM(t.to_amount), 0)::bigint AS returned_amount
+FROM transfer_reversals r
+JOIN transfers t ON t.id = r.transfer_id
+WHERE r.original_transfer_id = $1;
@@ -12,6 +12,15 @@ INSERT INTO users (
 SELECT * FROM users
 WHERE username = $1 LIMIT 1;
 
+-- name: GetUserForUpdate :one
+SELECT * FROM users
+WHERE username = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 LIMIT 1;
+
 -- name: UpdateUser :one
 UPDATE users
 SET
@@ -22,3 +31,25 @@ SET
   is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified)
 WHERE username = sqlc.arg(username)
 RETURNING *;
+
+-- name: UpdateUserFrozen :one
+UPDATE users
+SET is_frozen = $2
+WHERE username = $1
+RETURNING *;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE username = $1
+RETURNING *;
+
+-- name: ListStatementRecipients :many
+SELECT DISTINCT users.username
+FROM users
+JOIN accounts ON accounts.owner = users.username
+WHERE accounts.kind = 'customer'
+AND users.is_email_verified
+AND users.username > sqlc.arg(after_username)
+ORDER BY users.username
+LIMIT sqlc.arg('limit');
@@ -16,13 +16,13 @@ INSERT INTO accounts (
   currency
 ) VALUES (
   $1, $2, $3
-) RETURNING id, owner, balance, currency, created_at
+) RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
 `
 
 type CreateAccountParams struct {
-	Owner    string  `json:"owner"`
-	Balance  float64 `json:"balance"`
-	Currency string  `json:"currency"`
+	Owner    string `json:"owner"`
+	Balance  int64  `json:"balance"`
+	Currency string `json:"currency"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
@@ -34,28 +34,20 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
 	)
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1
-AND owner = $2
-`
-
-type DeleteAccountParams struct {
-	ID    int64  `json:"id"`
-	Owner string `json:"owner"`
-}
-
-func (q *Queries) DeleteAccount(ctx context.Context, arg DeleteAccountParams) error {
-	_, err := q.db.Exec(ctx, deleteAccount, arg.ID, arg.Owner)
-	return err
-}
-
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
@@ -68,12 +60,20 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
@@ -87,12 +87,54 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getSystemAccount = `-- name: GetSystemAccount :one
+SELECT id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status FROM accounts
+WHERE owner = 'system'
+AND kind = $1
+AND currency = $2
+LIMIT 1
+`
+
+type GetSystemAccountParams struct {
+	Kind     string `json:"kind"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error) {
+	row := q.db.QueryRow(ctx, getSystemAccount, arg.Kind, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status FROM accounts
 WHERE id > $1
 AND owner = $2
 ORDER BY id
@@ -120,6 +162,14 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.Kind,
+			&i.HeldBalance,
+			&i.AvailableBalance,
+			&i.MaxTransferAmount,
+			&i.DailyTransferLimit,
+			&i.MonthlyTransferLimit,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -131,22 +181,20 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
-const updateAccount = `-- name: UpdateAccount :one
+const updateAccountBalance = `-- name: UpdateAccountBalance :one
 UPDATE accounts
-SET balance = $2
-WHERE id = $1
-AND owner = $3
-RETURNING id, owner, balance, currency, created_at
+SET balance = balance + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
 `
 
-type UpdateAccountParams struct {
-	ID      int64   `json:"id"`
-	Balance float64 `json:"balance"`
-	Owner   string  `json:"owner"`
+type UpdateAccountBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
 }
 
-func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, updateAccount, arg.ID, arg.Balance, arg.Owner)
+func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountBalance, arg.Amount, arg.ID)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -154,24 +202,141 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
 	)
 	return i, err
 }
 
-const updateAccountBalance = `-- name: UpdateAccountBalance :one
+const updateAccountHeldBalance = `-- name: UpdateAccountHeldBalance :one
 UPDATE accounts
-SET balance = balance + $1
+SET held_balance = held_balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
 `
 
-type UpdateAccountBalanceParams struct {
-	Amount float64 `json:"amount"`
-	ID     int64   `json:"id"`
+type UpdateAccountHeldBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
 }
 
-func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error) {
-	row := q.db.QueryRow(ctx, updateAccountBalance, arg.Amount, arg.ID)
+func (q *Queries) UpdateAccountHeldBalance(ctx context.Context, arg UpdateAccountHeldBalanceParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountHeldBalance, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
+	)
+	return i, err
+}
+
+const updateAccountOverdraftLimit = `-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
+`
+
+type UpdateAccountOverdraftLimitParams struct {
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	ID             int64 `json:"id"`
+}
+
+func (q *Queries) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountOverdraftLimit, arg.OverdraftLimit, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
+	)
+	return i, err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
+`
+
+type UpdateAccountStatusParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountStatus, arg.Status, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
+	)
+	return i, err
+}
+
+const updateAccountTransferLimits = `-- name: UpdateAccountTransferLimits :one
+UPDATE accounts
+SET
+  max_transfer_amount = $1,
+  daily_transfer_limit = $2,
+  monthly_transfer_limit = $3
+WHERE id = $4
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, kind, held_balance, available_balance, max_transfer_amount, daily_transfer_limit, monthly_transfer_limit, status
+`
+
+type UpdateAccountTransferLimitsParams struct {
+	MaxTransferAmount    int64 `json:"max_transfer_amount"`
+	DailyTransferLimit   int64 `json:"daily_transfer_limit"`
+	MonthlyTransferLimit int64 `json:"monthly_transfer_limit"`
+	ID                   int64 `json:"id"`
+}
+
+func (q *Queries) UpdateAccountTransferLimits(ctx context.Context, arg UpdateAccountTransferLimitsParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountTransferLimits,
+		arg.MaxTransferAmount,
+		arg.DailyTransferLimit,
+		arg.MonthlyTransferLimit,
+		arg.ID,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -179,6 +344,14 @@ func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBal
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Kind,
+		&i.HeldBalance,
+		&i.AvailableBalance,
+		&i.MaxTransferAmount,
+		&i.DailyTransferLimit,
+		&i.MonthlyTransferLimit,
+		&i.Status,
 	)
 	return i, err
 }
@@ -13,8 +13,9 @@ func createRandomAccount(t *testing.T) Account {
 	user := CreateRandomUser(t)
 
 	arg := CreateAccountParams{
-		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
+		Owner: user.Username,
+		// enough funds for the concurrent transfer tests
+		Balance:  util.RandomInt(1000, 100000),
 		Currency: util.RandomCurrency(),
 	}
 
@@ -50,39 +51,33 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestUpdateAccount(t *testing.T) {
+func TestUpdateAccountOverdraftLimit(t *testing.T) {
 	account1 := createRandomAccount(t)
+	require.Zero(t, account1.OverdraftLimit)
 
-	arg := UpdateAccountParams{
-		ID:      account1.ID,
-		Balance: util.RandomMoney(),
-		Owner:   account1.Owner,
+	arg := UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: util.RandomMoney(),
 	}
 
-	account2, err := testStore.UpdateAccount(context.Background(), arg)
+	account2, err := testStore.UpdateAccountOverdraftLimit(context.Background(), arg)
 	require.NoError(t, err)
-	require.NotEmpty(t, account2)
-
 	require.Equal(t, account1.ID, account2.ID)
-	require.Equal(t, account1.Owner, account2.Owner)
-	require.NotEqual(t, account1.Balance, account2.Balance)
-	require.Equal(t, arg.Balance, account2.Balance)
-	require.Equal(t, account1.Currency, account2.Currency)
-	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, arg.OverdraftLimit, account2.OverdraftLimit)
 }
 
-func TestDeleteAccount(t *testing.T) {
+func TestUpdateAccountStatus(t *testing.T) {
 	account1 := createRandomAccount(t)
-	err := testStore.DeleteAccount(context.Background(), DeleteAccountParams{
-		ID:    account1.ID,
-		Owner: account1.Owner,
+	require.Equal(t, AccountStatusActive, account1.Status)
+
+	account2, err := testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: AccountStatusFrozen,
 	})
 	require.NoError(t, err)
-
-	account2, err := testStore.GetAccount(context.Background(), account1.ID)
-	require.Error(t, err)
-	require.EqualError(t, err, ErrRecordNotFound.Error())
-	require.Empty(t, account2)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, AccountStatusFrozen, account2.Status)
 }
 
 func TestListAccounts(t *testing.T) {
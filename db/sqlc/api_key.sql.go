@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: api_key.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createApiKey = `-- name: CreateApiKey :one
+INSERT INTO api_keys (
+  id,
+  username,
+  name,
+  hashed_secret,
+  scopes,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING id, username, name, hashed_secret, scopes, is_revoked, expires_at, last_used_at, created_at
+`
+
+type CreateApiKeyParams struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	Name         string    `json:"name"`
+	HashedSecret string    `json:"hashed_secret"`
+	Scopes       []string  `json:"scopes"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createApiKey,
+		arg.ID,
+		arg.Username,
+		arg.Name,
+		arg.HashedSecret,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Name,
+		&i.HashedSecret,
+		&i.Scopes,
+		&i.IsRevoked,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getApiKeyWithRole = `-- name: GetApiKeyWithRole :one
+SELECT api_keys.id, api_keys.username, api_keys.name, api_keys.hashed_secret, api_keys.scopes, api_keys.is_revoked, api_keys.expires_at, api_keys.last_used_at, api_keys.created_at, users.role, users.is_frozen
+FROM api_keys
+JOIN users ON users.username = api_keys.username
+WHERE api_keys.id = $1 LIMIT 1
+`
+
+type GetApiKeyWithRoleRow struct {
+	ApiKey   ApiKey `json:"api_key"`
+	Role     string `json:"role"`
+	IsFrozen bool   `json:"is_frozen"`
+}
+
+func (q *Queries) GetApiKeyWithRole(ctx context.Context, id uuid.UUID) (GetApiKeyWithRoleRow, error) {
+	row := q.db.QueryRow(ctx, getApiKeyWithRole, id)
+	var i GetApiKeyWithRoleRow
+	err := row.Scan(
+		&i.ApiKey.ID,
+		&i.ApiKey.Username,
+		&i.ApiKey.Name,
+		&i.ApiKey.HashedSecret,
+		&i.ApiKey.Scopes,
+		&i.ApiKey.IsRevoked,
+		&i.ApiKey.ExpiresAt,
+		&i.ApiKey.LastUsedAt,
+		&i.ApiKey.CreatedAt,
+		&i.Role,
+		&i.IsFrozen,
+	)
+	return i, err
+}
+
+const listApiKeys = `-- name: ListApiKeys :many
+SELECT id, username, name, hashed_secret, scopes, is_revoked, expires_at, last_used_at, created_at FROM api_keys
+WHERE username = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListApiKeys(ctx context.Context, username string) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listApiKeys, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKey{}
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Name,
+			&i.HashedSecret,
+			&i.Scopes,
+			&i.IsRevoked,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeApiKey = `-- name: RevokeApiKey :one
+UPDATE api_keys
+SET is_revoked = true
+WHERE id = $1
+AND username = $2
+RETURNING id, username, name, hashed_secret, scopes, is_revoked, expires_at, last_used_at, created_at
+`
+
+type RevokeApiKeyParams struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+func (q *Queries) RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, revokeApiKey, arg.ID, arg.Username)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Name,
+		&i.HashedSecret,
+		&i.Scopes,
+		&i.IsRevoked,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateApiKeyLastUsed = `-- name: UpdateApiKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE id = $1
+AND last_used_at < now() - interval '1 minute'
+`
+
+func (q *Queries) UpdateApiKeyLastUsed(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, updateApiKeyLastUsed, id)
+	return err
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func createRandomApiKey(t *testing.T, username string) ApiKey {
+	arg := CreateApiKeyParams{
+		ID:           uuid.New(),
+		Username:     username,
+		Name:         util.RandomString(6),
+		HashedSecret: util.RandomString(64),
+		Scopes:       []string{util.AccountsReadScope, util.TransfersReadScope},
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	apiKey, err := testStore.CreateApiKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, apiKey.ID)
+	require.Equal(t, arg.Username, apiKey.Username)
+	require.Equal(t, arg.Name, apiKey.Name)
+	require.Equal(t, arg.HashedSecret, apiKey.HashedSecret)
+	require.Equal(t, arg.Scopes, apiKey.Scopes)
+	require.WithinDuration(t, arg.ExpiresAt, apiKey.ExpiresAt, time.Second)
+	require.False(t, apiKey.IsRevoked)
+	require.True(t, apiKey.LastUsedAt.Before(apiKey.CreatedAt))
+
+	return apiKey
+}
+
+func TestCreateApiKey(t *testing.T) {
+	user := CreateRandomUser(t)
+	createRandomApiKey(t, user.Username)
+}
+
+func TestGetApiKeyWithRole(t *testing.T) {
+	user := CreateRandomUser(t)
+	apiKey := createRandomApiKey(t, user.Username)
+
+	row, err := testStore.GetApiKeyWithRole(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, row.ApiKey.ID)
+	require.Equal(t, apiKey.Scopes, row.ApiKey.Scopes)
+	require.Equal(t, user.Role, row.Role)
+
+	_, err = testStore.GetApiKeyWithRole(context.Background(), uuid.New())
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	user := CreateRandomUser(t)
+	other := CreateRandomUser(t)
+	apiKey := createRandomApiKey(t, user.Username)
+
+	// a key can only be revoked by its owner
+	_, err := testStore.RevokeApiKey(context.Background(), RevokeApiKeyParams{
+		ID:       apiKey.ID,
+		Username: other.Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	revoked, err := testStore.RevokeApiKey(context.Background(), RevokeApiKeyParams{
+		ID:       apiKey.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, revoked.IsRevoked)
+
+	apiKeys, err := testStore.ListApiKeys(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, apiKeys, 1)
+	require.True(t, apiKeys[0].IsRevoked)
+}
+
+func TestUpdateApiKeyLastUsed(t *testing.T) {
+	user := CreateRandomUser(t)
+	apiKey := createRandomApiKey(t, user.Username)
+
+	err := testStore.UpdateApiKeyLastUsed(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+
+	row, err := testStore.GetApiKeyWithRole(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), row.ApiKey.LastUsedAt, time.Second)
+
+	// uses within a minute of the last recorded one are not written again
+	lastUsedAt := row.ApiKey.LastUsedAt
+	err = testStore.UpdateApiKeyLastUsed(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+
+	row, err = testStore.GetApiKeyWithRole(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+	require.True(t, lastUsedAt.Equal(row.ApiKey.LastUsedAt))
+}
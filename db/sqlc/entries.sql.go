@@ -7,36 +7,71 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
+  journal_id,
   account_id,
-  amount
+  amount,
+  reference,
+  description
 ) VALUES (
-  $1, $2
-) RETURNING id, account_id, amount, created_at
+  $1, $2, $3, $4, $5
+) RETURNING id, account_id, amount, created_at, reference, description, journal_id
 `
 
 type CreateEntryParams struct {
-	AccountID int64   `json:"account_id"`
-	Amount    float64 `json:"amount"`
+	JournalID   int64  `json:"journal_id"`
+	AccountID   int64  `json:"account_id"`
+	Amount      int64  `json:"amount"`
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createEntry,
+		arg.JournalID,
+		arg.AccountID,
+		arg.Amount,
+		arg.Reference,
+		arg.Description,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Reference,
+		&i.Description,
+		&i.JournalID,
 	)
 	return i, err
 }
 
+const getBalanceBefore = `-- name: GetBalanceBefore :one
+SELECT COALESCE(sum(amount), 0)::bigint AS balance
+FROM entries
+WHERE account_id = $1
+AND created_at < $2
+`
+
+type GetBalanceBeforeParams struct {
+	AccountID  int64     `json:"account_id"`
+	BeforeTime time.Time `json:"before_time"`
+}
+
+func (q *Queries) GetBalanceBefore(ctx context.Context, arg GetBalanceBeforeParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getBalanceBefore, arg.AccountID, arg.BeforeTime)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, reference, description, journal_id FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,12 +83,15 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Reference,
+		&i.Description,
+		&i.JournalID,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, reference, description, journal_id FROM entries
 WHERE id > $1
 ORDER BY id
 LIMIT $2
@@ -78,6 +116,9 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Reference,
+			&i.Description,
+			&i.JournalID,
 		); err != nil {
 			return nil, err
 		}
@@ -90,7 +131,7 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 }
 
 const listEntriesByAccountId = `-- name: ListEntriesByAccountId :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, reference, description, journal_id FROM entries
 WHERE account_id = $1
 AND id > $2
 ORDER BY id
@@ -117,6 +158,71 @@ func (q *Queries) ListEntriesByAccountId(ctx context.Context, arg ListEntriesByA
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Reference,
+			&i.Description,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatementEntries = `-- name: ListStatementEntries :many
+SELECT entries.id, entries.account_id, entries.amount, entries.created_at, entries.reference, entries.description, entries.journal_id, journals.kind AS journal_kind,
+  COALESCE(transfers.id, 0)::bigint AS transfer_id,
+  (CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id
+    ELSE COALESCE(transfers.from_account_id, 0) END)::bigint AS counterparty_account_id,
+  (CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.recipient
+    ELSE COALESCE(transfers.sender, '') END)::varchar AS counterparty
+FROM entries
+JOIN journals ON journals.id = entries.journal_id
+LEFT JOIN transfers ON transfers.journal_id = entries.journal_id
+WHERE entries.account_id = $1
+AND entries.created_at >= $2
+AND entries.created_at < $3
+ORDER BY entries.created_at, entries.id
+`
+
+type ListStatementEntriesParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+type ListStatementEntriesRow struct {
+	Entry                 Entry  `json:"entry"`
+	JournalKind           string `json:"journal_kind"`
+	TransferID            int64  `json:"transfer_id"`
+	CounterpartyAccountID int64  `json:"counterparty_account_id"`
+	Counterparty          string `json:"counterparty"`
+}
+
+func (q *Queries) ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listStatementEntries, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementEntriesRow{}
+	for rows.Next() {
+		var i ListStatementEntriesRow
+		if err := rows.Scan(
+			&i.Entry.ID,
+			&i.Entry.AccountID,
+			&i.Entry.Amount,
+			&i.Entry.CreatedAt,
+			&i.Entry.Reference,
+			&i.Entry.Description,
+			&i.Entry.JournalID,
+			&i.JournalKind,
+			&i.TransferID,
+			&i.CounterpartyAccountID,
+			&i.Counterparty,
 		); err != nil {
 			return nil, err
 		}
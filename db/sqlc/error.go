@@ -13,6 +13,8 @@ const (
 )
 
 var ErrRecordNotFound = pgx.ErrNoRows
+var ErrInsufficientFunds = errors.New("insufficient funds")
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different request")
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
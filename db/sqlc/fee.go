@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"math/big"
+)
+
+// Types of transfers, each priced by its own fee rules
+const (
+	TransferTypeInternal      = "internal"
+	TransferTypeCrossCurrency = "cross_currency"
+	TransferTypeScheduled     = "scheduled"
+)
+
+// BasisPointsPerUnit is the number of basis points in 100%
+const BasisPointsPerUnit = 10000
+
+// TransferType returns the type of a transfer between accounts of the given currencies
+func TransferType(fromCurrency string, toCurrency string, scheduled bool) string {
+	switch {
+	case fromCurrency != toCurrency:
+		return TransferTypeCrossCurrency
+	case scheduled:
+		return TransferTypeScheduled
+	default:
+		return TransferTypeInternal
+	}
+}
+
+// IsSupportedTransferType returns true if fee rules can price transfers of the type
+func IsSupportedTransferType(transferType string) bool {
+	switch transferType {
+	case TransferTypeInternal, TransferTypeCrossCurrency, TransferTypeScheduled:
+		return true
+	}
+	return false
+}
+
+// TransferFeeParams contains the input parameters of TransferFee
+type TransferFeeParams struct {
+	// Currency is the one of the source account, which pays the fee
+	Currency     string `json:"currency"`
+	TransferType string `json:"transfer_type"`
+	Amount       int64  `json:"amount"`
+}
+
+// TransferFee returns the fee of a transfer, in minor units of its source currency.
+// The transfer is priced by the fee rule of its currency and type with the highest minimum amount
+// not above its amount, so that several rules of increasing minimum amounts make up the tiers of a price.
+// A rule charges its flat fee plus its basis points of the amount, rounded half up and capped by its max fee.
+// A transfer that no rule applies to is free.
+func TransferFee(ctx context.Context, q Querier, arg TransferFeeParams) (int64, error) {
+	rule, err := q.GetFeeRule(ctx, GetFeeRuleParams{
+		Currency:     arg.Currency,
+		TransferType: arg.TransferType,
+		Amount:       arg.Amount,
+	})
+	if err != nil {
+		if err == ErrRecordNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return rule.Fee(arg.Amount), nil
+}
+
+// Fee returns the fee the rule charges for a transfer of the given amount
+func (rule FeeRule) Fee(amount int64) int64 {
+	percentage := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rule.BasisPoints))
+	percentage.Add(percentage, big.NewInt(BasisPointsPerUnit/2))
+	percentage.Quo(percentage, big.NewInt(BasisPointsPerUnit))
+
+	fee := percentage.Add(percentage, big.NewInt(rule.FlatFee))
+	if rule.MaxFee > 0 && fee.Cmp(big.NewInt(rule.MaxFee)) > 0 {
+		return rule.MaxFee
+	}
+	return fee.Int64()
+}
@@ -0,0 +1,149 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: fee_rule.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createFeeRule = `-- name: CreateFeeRule :one
+INSERT INTO fee_rules (
+  currency,
+  transfer_type,
+  min_amount,
+  flat_fee,
+  basis_points,
+  max_fee
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING id, currency, transfer_type, min_amount, flat_fee, basis_points, max_fee, created_at
+`
+
+type CreateFeeRuleParams struct {
+	Currency     string `json:"currency"`
+	TransferType string `json:"transfer_type"`
+	MinAmount    int64  `json:"min_amount"`
+	FlatFee      int64  `json:"flat_fee"`
+	BasisPoints  int64  `json:"basis_points"`
+	MaxFee       int64  `json:"max_fee"`
+}
+
+func (q *Queries) CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, createFeeRule,
+		arg.Currency,
+		arg.TransferType,
+		arg.MinAmount,
+		arg.FlatFee,
+		arg.BasisPoints,
+		arg.MaxFee,
+	)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.TransferType,
+		&i.MinAmount,
+		&i.FlatFee,
+		&i.BasisPoints,
+		&i.MaxFee,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteFeeRule = `-- name: DeleteFeeRule :one
+DELETE FROM fee_rules
+WHERE id = $1
+RETURNING id, currency, transfer_type, min_amount, flat_fee, basis_points, max_fee, created_at
+`
+
+func (q *Queries) DeleteFeeRule(ctx context.Context, id int64) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, deleteFeeRule, id)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.TransferType,
+		&i.MinAmount,
+		&i.FlatFee,
+		&i.BasisPoints,
+		&i.MaxFee,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFeeRule = `-- name: GetFeeRule :one
+SELECT id, currency, transfer_type, min_amount, flat_fee, basis_points, max_fee, created_at FROM fee_rules
+WHERE currency = $1
+AND transfer_type = $2
+AND min_amount <= $3
+ORDER BY min_amount DESC
+LIMIT 1
+`
+
+type GetFeeRuleParams struct {
+	Currency     string `json:"currency"`
+	TransferType string `json:"transfer_type"`
+	Amount       int64  `json:"amount"`
+}
+
+func (q *Queries) GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, getFeeRule, arg.Currency, arg.TransferType, arg.Amount)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.TransferType,
+		&i.MinAmount,
+		&i.FlatFee,
+		&i.BasisPoints,
+		&i.MaxFee,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listFeeRules = `-- name: ListFeeRules :many
+SELECT id, currency, transfer_type, min_amount, flat_fee, basis_points, max_fee, created_at FROM fee_rules
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListFeeRulesParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (q *Queries) ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error) {
+	rows, err := q.db.Query(ctx, listFeeRules, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeRule{}
+	for rows.Next() {
+		var i FeeRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Currency,
+			&i.TransferType,
+			&i.MinAmount,
+			&i.FlatFee,
+			&i.BasisPoints,
+			&i.MaxFee,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
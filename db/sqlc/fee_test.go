@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createTestFeeRule creates a fee rule that is deleted again when the test finishes,
+// so that it does not price the transfers of other tests
+func createTestFeeRule(t *testing.T, arg CreateFeeRuleParams) FeeRule {
+	rule, err := testStore.CreateFeeRule(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, rule.ID)
+	require.Equal(t, arg.Currency, rule.Currency)
+	require.Equal(t, arg.TransferType, rule.TransferType)
+	require.Equal(t, arg.MinAmount, rule.MinAmount)
+
+	t.Cleanup(func() {
+		_, err := testStore.DeleteFeeRule(context.Background(), rule.ID)
+		require.NoError(t, err)
+	})
+
+	return rule
+}
+
+func TestFeeRuleFee(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rule   FeeRule
+		amount int64
+		fee    int64
+	}{
+		{"Flat", FeeRule{FlatFee: 25}, 1000, 25},
+		{"Percentage", FeeRule{BasisPoints: 150}, 1000, 15},
+		{"RoundHalfUp", FeeRule{BasisPoints: 50}, 1100, 6},
+		{"RoundDown", FeeRule{BasisPoints: 50}, 1099, 5},
+		{"FlatAndPercentage", FeeRule{FlatFee: 10, BasisPoints: 100}, 1000, 20},
+		{"Capped", FeeRule{FlatFee: 10, BasisPoints: 100, MaxFee: 15}, 1000, 15},
+		{"BelowCap", FeeRule{BasisPoints: 100, MaxFee: 15}, 1000, 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.fee, tc.rule.Fee(tc.amount))
+		})
+	}
+}
+
+func TestTransferFee(t *testing.T) {
+	account := createRandomAccount(t)
+
+	createTestFeeRule(t, CreateFeeRuleParams{
+		Currency:     account.Currency,
+		TransferType: TransferTypeScheduled,
+		FlatFee:      10,
+	})
+	createTestFeeRule(t, CreateFeeRuleParams{
+		Currency:     account.Currency,
+		TransferType: TransferTypeScheduled,
+		MinAmount:    5000,
+		BasisPoints:  100,
+		MaxFee:       80,
+	})
+
+	testCases := []struct {
+		transferType string
+		amount       int64
+		fee          int64
+	}{
+		{TransferTypeScheduled, 4999, 10},
+		{TransferTypeScheduled, 5000, 50},
+		{TransferTypeScheduled, 10000, 80},
+		{TransferTypeCrossCurrency, 10000, 0},
+	}
+
+	for _, tc := range testCases {
+		fee, err := TransferFee(context.Background(), testStore, TransferFeeParams{
+			Currency:     account.Currency,
+			TransferType: tc.transferType,
+			Amount:       tc.amount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.fee, fee)
+	}
+}
+
+func TestTransferType(t *testing.T) {
+	require.Equal(t, TransferTypeInternal, TransferType("USD", "USD", false))
+	require.Equal(t, TransferTypeScheduled, TransferType("USD", "USD", true))
+	require.Equal(t, TransferTypeCrossCurrency, TransferType("USD", "EUR", false))
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// HashTransferRequest returns a fingerprint of the transfer details,
+// used to detect an idempotency key being reused for a different transfer.
+// Both servers use it, so that a key can be retried over either of them.
+func HashTransferRequest(fromAccountID int64, toAccountID int64, amount int64, currency string) string {
+	data := fmt.Sprintf("%d:%d:%d:%s", fromAccountID, toAccountID, amount, currency)
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
+
+// claimIdempotencyKey inserts the idempotency key of the transfer.
+// If the key was already used by the same owner, the stored result is loaded into result and replayed is true.
+// A concurrent request with the same key blocks on the insert until the first transaction finishes.
+func claimIdempotencyKey(ctx context.Context, q *Queries, arg TransferTxParams, result *TransferTxResult) (replayed bool, err error) {
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Owner:          arg.Sender,
+		IdempotencyKey: arg.IdempotencyKey,
+		RequestHash:    arg.RequestHash,
+	})
+	if err == nil {
+		return false, nil
+	}
+	if err != ErrRecordNotFound {
+		return false, err
+	}
+
+	// the key exists already
+	key, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Owner:          arg.Sender,
+		IdempotencyKey: arg.IdempotencyKey,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if key.RequestHash != arg.RequestHash || key.Response == nil {
+		return false, ErrIdempotencyKeyReused
+	}
+
+	if err := json.Unmarshal(key.Response, result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal stored response: %w", err)
+	}
+
+	return true, nil
+}
+
+// storeIdempotentResult saves the transfer result against its idempotency key
+func storeIdempotentResult(ctx context.Context, q *Queries, arg TransferTxParams, result TransferTxResult) error {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	_, err = q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		Owner:          arg.Sender,
+		IdempotencyKey: arg.IdempotencyKey,
+		Response:       response,
+	})
+	return err
+}
@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (
+  owner,
+  idempotency_key,
+  request_hash
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (owner, idempotency_key) DO NOTHING
+RETURNING owner, idempotency_key, request_hash, response, created_at
+`
+
+type CreateIdempotencyKeyParams struct {
+	Owner          string `json:"owner"`
+	IdempotencyKey string `json:"idempotency_key"`
+	RequestHash    string `json:"request_hash"`
+}
+
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, createIdempotencyKey, arg.Owner, arg.IdempotencyKey, arg.RequestHash)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Owner,
+		&i.IdempotencyKey,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT owner, idempotency_key, request_hash, response, created_at FROM idempotency_keys
+WHERE owner = $1
+AND idempotency_key = $2
+LIMIT 1
+`
+
+type GetIdempotencyKeyParams struct {
+	Owner          string `json:"owner"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.Owner, arg.IdempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Owner,
+		&i.IdempotencyKey,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateIdempotencyKeyResponse = `-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET response = $3
+WHERE owner = $1
+AND idempotency_key = $2
+RETURNING owner, idempotency_key, request_hash, response, created_at
+`
+
+type UpdateIdempotencyKeyResponseParams struct {
+	Owner          string `json:"owner"`
+	IdempotencyKey string `json:"idempotency_key"`
+	Response       []byte `json:"response"`
+}
+
+func (q *Queries) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, updateIdempotencyKeyResponse, arg.Owner, arg.IdempotencyKey, arg.Response)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Owner,
+		&i.IdempotencyKey,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: journal.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createJournal = `-- name: CreateJournal :one
+INSERT INTO journals (
+  kind
+) VALUES (
+  $1
+) RETURNING id, kind, created_at
+`
+
+func (q *Queries) CreateJournal(ctx context.Context, kind string) (Journal, error) {
+	row := q.db.QueryRow(ctx, createJournal, kind)
+	var i Journal
+	err := row.Scan(&i.ID, &i.Kind, &i.CreatedAt)
+	return i, err
+}
+
+const getJournal = `-- name: GetJournal :one
+SELECT id, kind, created_at FROM journals
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetJournal(ctx context.Context, id int64) (Journal, error) {
+	row := q.db.QueryRow(ctx, getJournal, id)
+	var i Journal
+	err := row.Scan(&i.ID, &i.Kind, &i.CreatedAt)
+	return i, err
+}
+
+const listBalanceMismatches = `-- name: ListBalanceMismatches :many
+SELECT accounts.id, accounts.owner, accounts.currency, accounts.balance,
+  COALESCE(sum(entries.amount), 0)::bigint AS entry_sum
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+GROUP BY accounts.id
+HAVING accounts.balance <> COALESCE(sum(entries.amount), 0)
+ORDER BY accounts.id
+`
+
+type ListBalanceMismatchesRow struct {
+	ID       int64  `json:"id"`
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+	Balance  int64  `json:"balance"`
+	EntrySum int64  `json:"entry_sum"`
+}
+
+func (q *Queries) ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error) {
+	rows, err := q.db.Query(ctx, listBalanceMismatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBalanceMismatchesRow{}
+	for rows.Next() {
+		var i ListBalanceMismatchesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Currency,
+			&i.Balance,
+			&i.EntrySum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJournalEntries = `-- name: ListJournalEntries :many
+SELECT id, account_id, amount, created_at, reference, description, journal_id FROM entries
+WHERE journal_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListJournalEntries(ctx context.Context, journalID int64) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, listJournalEntries, journalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Reference,
+			&i.Description,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnbalancedJournals = `-- name: ListUnbalancedJournals :many
+SELECT entries.journal_id, accounts.currency, sum(entries.amount)::bigint AS total
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+JOIN journals ON journals.id = entries.journal_id
+WHERE journals.kind <> 'legacy'
+GROUP BY entries.journal_id, accounts.currency
+HAVING sum(entries.amount) <> 0
+ORDER BY entries.journal_id
+`
+
+type ListUnbalancedJournalsRow struct {
+	JournalID int64  `json:"journal_id"`
+	Currency  string `json:"currency"`
+	Total     int64  `json:"total"`
+}
+
+func (q *Queries) ListUnbalancedJournals(ctx context.Context) ([]ListUnbalancedJournalsRow, error) {
+	rows, err := q.db.Query(ctx, listUnbalancedJournals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnbalancedJournalsRow{}
+	for rows.Next() {
+		var i ListUnbalancedJournalsRow
+		if err := rows.Scan(&i.JournalID, &i.Currency, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// SystemUsername owns the system accounts of the bank itself
+const SystemUsername = "system"
+
+// ErrSystemUser is returned when the system user would be changed like a customer or staff user
+var ErrSystemUser = errors.New("the system user cannot be changed")
+
+// Kinds of accounts. Every kind but customer is a system account owned by SystemUsername,
+// and there is one system account of each kind per currency.
+const (
+	AccountKindCustomer = "customer"
+	// AccountKindCash settles deposits and withdrawals with the world outside the bank
+	AccountKindCash = "cash"
+	// AccountKindFees collects the fees charged to customers
+	AccountKindFees = "fees"
+	// AccountKindFX takes the other side of currency conversions
+	AccountKindFX = "fx"
+)
+
+// Kinds of journals
+const (
+	JournalKindTransfer   = "transfer"
+	JournalKindDeposit    = "deposit"
+	JournalKindWithdrawal = "withdrawal"
+	JournalKindReversal   = "reversal"
+)
+
+// ErrSystemAccount is returned when a customer operation would move money in or out of a system account
+var ErrSystemAccount = errors.New("system accounts cannot be used for this operation")
+
+// ErrUnbalancedJournal is returned when the postings of a journal do not sum to zero in every currency
+var ErrUnbalancedJournal = errors.New("journal postings do not sum to zero")
+
+// Posting adds Amount, in minor units of its currency, to the balance of an account
+type Posting struct {
+	AccountID   int64
+	Amount      int64
+	Reference   string
+	Description string
+}
+
+// JournalResult is the result of posting a journal
+type JournalResult struct {
+	Journal Journal
+	// Entries holds the entry of each posting, in the order of the postings
+	Entries []Entry
+	// Accounts holds the accounts of the postings after their balance update, by id
+	Accounts map[int64]Account
+}
+
+// postJournal records a financial operation as a journal of postings, one entry each,
+// and updates the balances of their accounts. The postings must sum to zero in every currency.
+// The accounts are locked in the order of their ids, so that concurrent journals cannot deadlock,
+// and must all be active, see ErrAccountNotActive.
+// It must run inside a database transaction.
+func postJournal(ctx context.Context, q *Queries, kind string, postings []Posting) (JournalResult, error) {
+	result := JournalResult{
+		Accounts: make(map[int64]Account),
+	}
+
+	amounts := make(map[int64]int64)
+	for _, posting := range postings {
+		amounts[posting.AccountID] += posting.Amount
+	}
+
+	accountIDs := make([]int64, 0, len(amounts))
+	for accountID := range amounts {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	totals := make(map[string]int64)
+	for _, accountID := range accountIDs {
+		account, err := q.GetAccountForUpdate(ctx, accountID)
+		if err != nil {
+			return result, err
+		}
+		if err := activeAccount(account); err != nil {
+			return result, err
+		}
+		totals[account.Currency] += amounts[accountID]
+	}
+
+	for currency, total := range totals {
+		if total != 0 {
+			return result, fmt.Errorf("%w: %d off in %s", ErrUnbalancedJournal, total, currency)
+		}
+	}
+
+	var err error
+	result.Journal, err = q.CreateJournal(ctx, kind)
+	if err != nil {
+		return result, err
+	}
+
+	result.Entries = make([]Entry, len(postings))
+	for i, posting := range postings {
+		result.Entries[i], err = q.CreateEntry(ctx, CreateEntryParams{
+			JournalID:   result.Journal.ID,
+			AccountID:   posting.AccountID,
+			Amount:      posting.Amount,
+			Reference:   posting.Reference,
+			Description: posting.Description,
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	for _, accountID := range accountIDs {
+		account, err := q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
+			ID:     accountID,
+			Amount: amounts[accountID],
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Accounts[accountID] = account
+	}
+
+	return result, nil
+}
+
+// LedgerReport lists the inconsistencies found by VerifyLedger
+type LedgerReport struct {
+	// BalanceMismatches holds the accounts whose stored balance differs from the sum of their entries
+	BalanceMismatches []ListBalanceMismatchesRow `json:"balance_mismatches"`
+	// UnbalancedJournals holds the journals whose postings do not sum to zero in a currency.
+	// Legacy journals, which hold the entries written before journals existed, are not checked.
+	UnbalancedJournals []ListUnbalancedJournalsRow `json:"unbalanced_journals"`
+}
+
+// IsConsistent returns true if no inconsistency was found
+func (report LedgerReport) IsConsistent() bool {
+	return len(report.BalanceMismatches) == 0 && len(report.UnbalancedJournals) == 0
+}
+
+// VerifyLedger scans every account and journal, and reports the accounts whose stored balance
+// is not the sum of their entries, and the journals that do not balance
+func (store *SQLStore) VerifyLedger(ctx context.Context) (LedgerReport, error) {
+	var report LedgerReport
+	var err error
+
+	report.BalanceMismatches, err = store.ListBalanceMismatches(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	report.UnbalancedJournals, err = store.ListUnbalancedJournals(ctx)
+	return report, err
+}
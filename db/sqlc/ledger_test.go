@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestPostJournalUnbalanced(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	err := testStore.(*SQLStore).execTx(context.Background(), func(q *Queries) error {
+		_, err := postJournal(context.Background(), q, JournalKindTransfer, []Posting{
+			{AccountID: account1.ID, Amount: -10},
+			{AccountID: account2.ID, Amount: 5},
+		})
+		return err
+	})
+	require.ErrorIs(t, err, ErrUnbalancedJournal)
+
+	account, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, account.Balance)
+}
+
+func TestTransferTxJournal(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	toAmount, exchangeRate := int64(10), "1"
+	if account1.Currency != account2.Currency {
+		toAmount, exchangeRate = 9, "0.9"
+	}
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		ToAmount:      toAmount,
+		ExchangeRate:  exchangeRate,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Transfer.JournalID)
+	require.Equal(t, result.Transfer.JournalID, result.FromEntry.JournalID)
+	require.Equal(t, result.Transfer.JournalID, result.ToEntry.JournalID)
+
+	journal, err := testStore.GetJournal(context.Background(), result.Transfer.JournalID)
+	require.NoError(t, err)
+	require.Equal(t, JournalKindTransfer, journal.Kind)
+
+	entries, err := testStore.ListJournalEntries(context.Background(), journal.ID)
+	require.NoError(t, err)
+	if account1.Currency == account2.Currency {
+		require.Len(t, entries, 2)
+	} else {
+		require.Len(t, entries, 4)
+	}
+
+	totals := make(map[string]int64)
+	for _, entry := range entries {
+		account, err := testStore.GetAccount(context.Background(), entry.AccountID)
+		require.NoError(t, err)
+		totals[account.Currency] += entry.Amount
+	}
+	for _, total := range totals {
+		require.Zero(t, total)
+	}
+
+	report, err := testStore.VerifyLedger(context.Background())
+	require.NoError(t, err)
+	for _, unbalanced := range report.UnbalancedJournals {
+		require.NotEqual(t, journal.ID, unbalanced.JournalID)
+	}
+}
+
+func TestVerifyLedger(t *testing.T) {
+	// an account created with an opening balance has no entries to back it
+	account := createRandomAccount(t)
+
+	report, err := testStore.VerifyLedger(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.IsConsistent())
+
+	var found bool
+	for _, mismatch := range report.BalanceMismatches {
+		if mismatch.ID == account.ID {
+			found = true
+			require.Equal(t, account.Balance, mismatch.Balance)
+			require.Zero(t, mismatch.EntrySum)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestGetSystemAccount(t *testing.T) {
+	currency := util.RandomCurrency()
+
+	for _, kind := range []string{AccountKindCash, AccountKindFees, AccountKindFX} {
+		account, err := testStore.GetSystemAccount(context.Background(), GetSystemAccountParams{
+			Kind:     kind,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+		require.Equal(t, SystemUsername, account.Owner)
+		require.Equal(t, kind, account.Kind)
+		require.Equal(t, currency, account.Currency)
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: login_attempt.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const countLoginFailuresByClientIp = `-- name: CountLoginFailuresByClientIp :one
+SELECT count(*) FROM login_attempts
+WHERE
+    client_ip = $1
+    AND is_successful = FALSE
+    AND created_at > $2
+`
+
+type CountLoginFailuresByClientIpParams struct {
+	ClientIp string    `json:"client_ip"`
+	Since    time.Time `json:"since"`
+}
+
+func (q *Queries) CountLoginFailuresByClientIp(ctx context.Context, arg CountLoginFailuresByClientIpParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLoginFailuresByClientIp, arg.ClientIp, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countLoginFailuresByUsername = `-- name: CountLoginFailuresByUsername :one
+SELECT count(*) FROM login_attempts AS a
+WHERE
+    a.username = $1
+    AND a.is_successful = FALSE
+    AND a.created_at > $2
+    AND NOT EXISTS (
+        SELECT 1 FROM login_attempts AS s
+        WHERE
+            s.username = a.username
+            AND s.is_successful = TRUE
+            AND s.created_at > a.created_at
+    )
+`
+
+type CountLoginFailuresByUsernameParams struct {
+	Username string    `json:"username"`
+	Since    time.Time `json:"since"`
+}
+
+func (q *Queries) CountLoginFailuresByUsername(ctx context.Context, arg CountLoginFailuresByUsernameParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLoginFailuresByUsername, arg.Username, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLoginAttempt = `-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (
+    username,
+    client_ip,
+    is_successful
+) VALUES (
+    $1, $2, $3
+) RETURNING id, username, client_ip, is_successful, created_at
+`
+
+type CreateLoginAttemptParams struct {
+	Username     string `json:"username"`
+	ClientIp     string `json:"client_ip"`
+	IsSuccessful bool   `json:"is_successful"`
+}
+
+func (q *Queries) CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error) {
+	row := q.db.QueryRow(ctx, createLoginAttempt, arg.Username, arg.ClientIp, arg.IsSuccessful)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.ClientIp,
+		&i.IsSuccessful,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,74 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: login_lockout.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createLoginLockout = `-- name: CreateLoginLockout :one
+INSERT INTO login_lockouts (
+    subject_type,
+    subject,
+    failed_attempts,
+    locked_until
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, subject_type, subject, failed_attempts, locked_until, created_at
+`
+
+type CreateLoginLockoutParams struct {
+	SubjectType    string    `json:"subject_type"`
+	Subject        string    `json:"subject"`
+	FailedAttempts int64     `json:"failed_attempts"`
+	LockedUntil    time.Time `json:"locked_until"`
+}
+
+func (q *Queries) CreateLoginLockout(ctx context.Context, arg CreateLoginLockoutParams) (LoginLockout, error) {
+	row := q.db.QueryRow(ctx, createLoginLockout,
+		arg.SubjectType,
+		arg.Subject,
+		arg.FailedAttempts,
+		arg.LockedUntil,
+	)
+	var i LoginLockout
+	err := row.Scan(
+		&i.ID,
+		&i.SubjectType,
+		&i.Subject,
+		&i.FailedAttempts,
+		&i.LockedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestLoginLockout = `-- name: GetLatestLoginLockout :one
+SELECT id, subject_type, subject, failed_attempts, locked_until, created_at FROM login_lockouts
+WHERE subject_type = $1 AND subject = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestLoginLockoutParams struct {
+	SubjectType string `json:"subject_type"`
+	Subject     string `json:"subject"`
+}
+
+func (q *Queries) GetLatestLoginLockout(ctx context.Context, arg GetLatestLoginLockoutParams) (LoginLockout, error) {
+	row := q.db.QueryRow(ctx, getLatestLoginLockout, arg.SubjectType, arg.Subject)
+	var i LoginLockout
+	err := row.Scan(
+		&i.ID,
+		&i.SubjectType,
+		&i.Subject,
+		&i.FailedAttempts,
+		&i.LockedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
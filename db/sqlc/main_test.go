@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/util"
 )
 
@@ -22,6 +23,11 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		log.Fatal("Cannot connect to db", err)
 	}
-	testStore = NewStore(connPool)
+	rateProvider, err := fx.NewStaticRateProvider(nil)
+	if err != nil {
+		log.Fatal("cannot create rate provider:", err)
+	}
+
+	testStore = NewStore(connPool, nil, rateProvider)
 	os.Exit(m.Run())
 }
@@ -8,24 +8,190 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
-	Balance   float64   `json:"balance"`
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+	// in minor units of the account currency
+	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// how far below zero the balance may go, in minor units
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// customer, or the cash, fees or fx system account of the currency
+	Kind string `json:"kind"`
+	// reserved by authorized holds, in minor units of the account currency
+	HeldBalance int64 `json:"held_balance"`
+	// balance less held_balance, what transfers and withdrawals may spend
+	AvailableBalance int64 `json:"available_balance"`
+	// overrides the default limit of the currency, 0 to keep it
+	MaxTransferAmount int64 `json:"max_transfer_amount"`
+	// overrides the default limit of the currency, 0 to keep it
+	DailyTransferLimit int64 `json:"daily_transfer_limit"`
+	// overrides the default limit of the currency, 0 to keep it
+	MonthlyTransferLimit int64 `json:"monthly_transfer_limit"`
+	// active, frozen or closed; only active accounts take part in journals and holds
+	Status string `json:"status"`
+}
+
+type AccountStatusChange struct {
+	ID         int64  `json:"id"`
+	AccountID  int64  `json:"account_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	// the owner closing the account, or the banker freezing, unfreezing or reopening it
+	ChangedBy string    `json:"changed_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ApiKey struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Name     string    `json:"name"`
+	// sha256 of the secret part of the key, which is only shown once
+	HashedSecret string    `json:"hashed_secret"`
+	Scopes       []string  `json:"scopes"`
+	IsRevoked    bool      `json:"is_revoked"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	// updated at most once a minute
+	LastUsedAt time.Time `json:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type Entry struct {
 	ID        int64 `json:"id"`
 	AccountID int64 `json:"account_id"`
-	// can be positive or negative
-	Amount    float64   `json:"amount"`
+	// can be positive or negative, in minor units
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+	// external reference of a deposit or withdrawal, posted at most once per account
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
+	JournalID   int64  `json:"journal_id"`
+}
+
+// a transfer is priced by the rule of its currency and type with the highest min_amount not above its amount
+type FeeRule struct {
+	ID int64 `json:"id"`
+	// of the source account, which pays the fee
+	Currency string `json:"currency"`
+	// internal, cross_currency or scheduled
+	TransferType string `json:"transfer_type"`
+	// lower bound of the tier, in minor units
+	MinAmount int64 `json:"min_amount"`
+	FlatFee   int64 `json:"flat_fee"`
+	// percentage of the amount, in hundredths of a percent
+	BasisPoints int64 `json:"basis_points"`
+	// cap of the fee, 0 for none
+	MaxFee    int64     `json:"max_fee"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Hold struct {
+	ID int64 `json:"id"`
+	// the account the money is held on
+	AccountID int64 `json:"account_id"`
+	// the account a capture pays into
+	ToAccountID int64  `json:"to_account_id"`
+	Sender      string `json:"sender"`
+	Recipient   string `json:"recipient"`
+	// in minor units of the currency both accounts share
+	Amount         int64  `json:"amount"`
+	Status         string `json:"status"`
+	CapturedAmount int64  `json:"captured_amount"`
+	// the transfer of the capture, 0 until captured
+	TransferID int64     `json:"transfer_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	ClosedAt   time.Time `json:"closed_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Owner          string `json:"owner"`
+	IdempotencyKey string `json:"idempotency_key"`
+	RequestHash    string `json:"request_hash"`
+	// stored result returned on replay
+	Response  []byte    `json:"response"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Journal struct {
+	ID int64 `json:"id"`
+	// transfer, reversal, deposit, withdrawal or legacy
+	Kind      string    `json:"kind"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LoginAttempt struct {
+	ID int64 `json:"id"`
+	// as sent by the client, the user may not exist
+	Username     string    `json:"username"`
+	ClientIp     string    `json:"client_ip"`
+	IsSuccessful bool      `json:"is_successful"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type LoginLockout struct {
+	ID int64 `json:"id"`
+	// username or client_ip
+	SubjectType    string    `json:"subject_type"`
+	Subject        string    `json:"subject"`
+	FailedAttempts int64     `json:"failed_attempts"`
+	LockedUntil    time.Time `json:"locked_until"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type PasswordReset struct {
+	ID         int64     `json:"id"`
+	Username   string    `json:"username"`
+	SecretCode string    `json:"secret_code"`
+	IsUsed     bool      `json:"is_used"`
+	ExpiredAt  time.Time `json:"expired_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RecoveryCode struct {
+	ID         int64     `json:"id"`
+	Username   string    `json:"username"`
+	HashedCode string    `json:"hashed_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RevokedToken struct {
+	// id of the revoked access token
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	ExpiresAt time.Time `json:"expires_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type ScheduledTransfer struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	// in minor units of the currency both accounts share
+	Amount    int64  `json:"amount"`
+	Frequency string `json:"frequency"`
+	// day a monthly transfer runs on, the last day of shorter months
+	DayOfMonth int32 `json:"day_of_month"`
+	// when the next run is due
+	NextRunAt time.Time `json:"next_run_at"`
+	Status    string    `json:"status"`
+	// failed attempts of the next run
+	FailedAttempts int32     `json:"failed_attempts"`
+	LastError      string    `json:"last_error"`
+	LastTransferID int64     `json:"last_transfer_id"`
+	LastRunAt      time.Time `json:"last_run_at"`
+	// skipped until then, while a worker runs it or before a retry
+	ClaimedUntil time.Time `json:"claimed_until"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
 type Session struct {
 	ID           uuid.UUID `json:"id"`
 	Username     string    `json:"username"`
@@ -35,6 +201,21 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// id of the session created at login, shared by every session rotated from it
+	FamilyID uuid.UUID `json:"family_id"`
+	// the refresh token has been exchanged for a new one and must not be used again
+	IsRotated bool `json:"is_rotated"`
+	// the access token issued with the refresh token of the session
+	AccessTokenID        uuid.UUID `json:"access_token_id"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+}
+
+type TotpCredential struct {
+	Username        string    `json:"username"`
+	Secret          string    `json:"secret"`
+	IsEnabled       bool      `json:"is_enabled"`
+	LastUsedCounter int64     `json:"last_used_counter"`
+	CreatedAt       time.Time `json:"created_at"`
 }
 
 type Transfer struct {
@@ -43,9 +224,29 @@ type Transfer struct {
 	ToAccountID   int64  `json:"to_account_id"`
 	Sender        string `json:"sender"`
 	Recipient     string `json:"recipient"`
-	// must be positive
-	Amount    float64   `json:"amount"`
+	// must be positive, debited in minor units of the source account currency
+	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// credited in minor units of the destination account currency
+	ToAmount int64 `json:"to_amount"`
+	// units of the destination currency bought by one unit of the source currency
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
+	JournalID    int64          `json:"journal_id"`
+	// original, reversed or partially_refunded
+	Status string `json:"status"`
+	// sent back by reversals, in minor units of the destination account currency
+	RefundedAmount int64 `json:"refunded_amount"`
+	// charged to the source account on top of the amount, in minor units of its currency
+	Fee int64 `json:"fee"`
+}
+
+type TransferReversal struct {
+	// the compensating transfer, from the recipient back to the sender
+	TransferID         int64     `json:"transfer_id"`
+	OriginalTransferID int64     `json:"original_transfer_id"`
+	Reason             string    `json:"reason"`
+	ReversedBy         string    `json:"reversed_by"`
+	CreatedAt          time.Time `json:"created_at"`
 }
 
 type User struct {
@@ -56,6 +257,8 @@ type User struct {
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
+	Role              string    `json:"role"`
+	IsFrozen          bool      `json:"is_frozen"`
 }
 
 type VerifyEmail struct {
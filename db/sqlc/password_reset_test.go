@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func createRandomPasswordReset(t *testing.T, username string) PasswordReset {
+	arg := CreatePasswordResetParams{
+		Username:   username,
+		SecretCode: util.RandomString(64),
+	}
+
+	passwordReset, err := testStore.CreatePasswordReset(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, passwordReset.ID)
+	require.Equal(t, arg.Username, passwordReset.Username)
+	require.Equal(t, arg.SecretCode, passwordReset.SecretCode)
+	require.False(t, passwordReset.IsUsed)
+	require.True(t, passwordReset.ExpiredAt.After(passwordReset.CreatedAt))
+
+	return passwordReset
+}
+
+func TestGetUserByEmail(t *testing.T) {
+	user1 := CreateRandomUser(t)
+
+	user2, err := testStore.GetUserByEmail(context.Background(), user1.Email)
+	require.NoError(t, err)
+	require.Equal(t, user1.Username, user2.Username)
+}
+
+func TestResetPasswordTx(t *testing.T) {
+	user := CreateRandomUser(t)
+	session := createRandomSession(t, user.Username)
+	passwordReset := createRandomPasswordReset(t, user.Username)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(8))
+	require.NoError(t, err)
+
+	arg := ResetPasswordTxParams{
+		PasswordResetId: passwordReset.ID,
+		SecretCode:      util.RandomString(64),
+		HashedPassword:  hashedPassword,
+	}
+
+	// a wrong code changes nothing
+	_, err = testStore.ResetPasswordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	arg.SecretCode = passwordReset.SecretCode
+	result, err := testStore.ResetPasswordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.PasswordReset.IsUsed)
+	require.Equal(t, hashedPassword, result.User.HashedPassword)
+	require.True(t, result.User.PasswordChangedAt.After(user.PasswordChangedAt))
+
+	blocked, err := testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	// the code can only be used once
+	_, err = testStore.ResetPasswordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -11,30 +11,106 @@ import (
 )
 
 type Querier interface {
+	BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error)
+	BlockSession(ctx context.Context, arg BlockSessionParams) (Session, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error
+	BlockUserSessions(ctx context.Context, username string) error
+	CancelScheduledTransfer(ctx context.Context, arg CancelScheduledTransferParams) (ScheduledTransfer, error)
+	CancelScheduledTransfersByAccount(ctx context.Context, accountID int64) error
+	ClaimDueScheduledTransfers(ctx context.Context, arg ClaimDueScheduledTransfersParams) ([]ScheduledTransfer, error)
+	CloseHold(ctx context.Context, arg CloseHoldParams) (Hold, error)
+	CountLoginFailuresByClientIp(ctx context.Context, arg CountLoginFailuresByClientIpParams) (int64, error)
+	CountLoginFailuresByUsername(ctx context.Context, arg CountLoginFailuresByUsernameParams) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountStatusChange(ctx context.Context, arg CreateAccountStatusChangeParams) (AccountStatusChange, error)
+	CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateJournal(ctx context.Context, kind string) (Journal, error)
+	CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error)
+	CreateLoginLockout(ctx context.Context, arg CreateLoginLockoutParams) (LoginLockout, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (RecoveryCode, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferReversal(ctx context.Context, arg CreateTransferReversalParams) (TransferReversal, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteAccount(ctx context.Context, arg DeleteAccountParams) error
+	DeleteExpiredRevokedTokens(ctx context.Context) (int64, error)
+	DeleteFeeRule(ctx context.Context, id int64) (FeeRule, error)
+	DeleteRecoveryCodes(ctx context.Context, username string) error
+	EnableTotpCredential(ctx context.Context, arg EnableTotpCredentialParams) (TotpCredential, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetApiKeyWithRole(ctx context.Context, id uuid.UUID) (GetApiKeyWithRoleRow, error)
+	GetBalanceBefore(ctx context.Context, arg GetBalanceBeforeParams) (int64, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetJournal(ctx context.Context, id int64) (Journal, error)
+	GetLatestLoginLockout(ctx context.Context, arg GetLatestLoginLockoutParams) (LoginLockout, error)
+	GetReturnedAmount(ctx context.Context, originalTransferID int64) (int64, error)
+	GetScheduledTransfer(ctx context.Context, arg GetScheduledTransferParams) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error)
+	GetTotpCredential(ctx context.Context, username string) (TotpCredential, error)
 	GetTransfer(ctx context.Context, arg GetTransferParams) (Transfer, error)
+	GetTransferById(ctx context.Context, id int64) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
+	GetTransferReversal(ctx context.Context, transferID int64) (TransferReversal, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserForUpdate(ctx context.Context, username string) (User, error)
+	IsTokenRevoked(ctx context.Context, id uuid.UUID) (bool, error)
+	ListAccountStatusChanges(ctx context.Context, accountID int64) ([]AccountStatusChange, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListActiveSessions(ctx context.Context, username string) ([]Session, error)
+	ListApiKeys(ctx context.Context, username string) ([]ApiKey, error)
+	ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
 	ListEntriesByAccountId(ctx context.Context, arg ListEntriesByAccountIdParams) ([]Entry, error)
+	ListExpiredHolds(ctx context.Context, limit int32) ([]int64, error)
+	ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error)
+	ListJournalEntries(ctx context.Context, journalID int64) ([]Entry, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error)
+	ListStatementRecipients(ctx context.Context, arg ListStatementRecipientsParams) ([]string, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
 	ListTransfersByFromAccount(ctx context.Context, arg ListTransfersByFromAccountParams) ([]Transfer, error)
 	ListTransfersByFromAndToAccount(ctx context.Context, arg ListTransfersByFromAndToAccountParams) ([]Transfer, error)
 	ListTransfersByToAccount(ctx context.Context, arg ListTransfersByToAccountParams) ([]Transfer, error)
-	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	ListUnbalancedJournals(ctx context.Context) ([]ListUnbalancedJournalsRow, error)
+	RecordScheduledTransferRun(ctx context.Context, arg RecordScheduledTransferRunParams) (ScheduledTransfer, error)
+	RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error)
+	RevokeFamilyAccessTokens(ctx context.Context, familyID uuid.UUID) ([]RevokedToken, error)
+	RevokeOtherAccessTokens(ctx context.Context, arg RevokeOtherAccessTokensParams) ([]RevokedToken, error)
+	RevokeUserAccessTokens(ctx context.Context, username string) ([]RevokedToken, error)
+	RotateSession(ctx context.Context, id uuid.UUID) (Session, error)
+	SumOutgoingTransfersByAccount(ctx context.Context, arg SumOutgoingTransfersByAccountParams) (int64, error)
+	SumOutgoingTransfersByOwner(ctx context.Context, arg SumOutgoingTransfersByOwnerParams) ([]SumOutgoingTransfersByOwnerRow, error)
 	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error)
+	UpdateAccountHeldBalance(ctx context.Context, arg UpdateAccountHeldBalanceParams) (Account, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateAccountTransferLimits(ctx context.Context, arg UpdateAccountTransferLimitsParams) (Account, error)
+	UpdateApiKeyLastUsed(ctx context.Context, id uuid.UUID) error
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error)
+	UpdatePasswordReset(ctx context.Context, arg UpdatePasswordResetParams) (PasswordReset, error)
+	UpdateScheduledTransferAmount(ctx context.Context, arg UpdateScheduledTransferAmountParams) (ScheduledTransfer, error)
+	UpdateSessionAccessToken(ctx context.Context, arg UpdateSessionAccessTokenParams) error
+	UpdateTotpLastUsedCounter(ctx context.Context, arg UpdateTotpLastUsedCounterParams) (TotpCredential, error)
+	UpdateTransferRefund(ctx context.Context, arg UpdateTransferRefundParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserFrozen(ctx context.Context, arg UpdateUserFrozenParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertTotpCredential(ctx context.Context, arg UpsertTotpCredentialParams) (TotpCredential, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (RecoveryCode, error)
 }
 
 var _ Querier = (*Queries)(nil)
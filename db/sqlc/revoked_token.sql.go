@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: revoked_token.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteExpiredRevokedTokens = `-- name: DeleteExpiredRevokedTokens :execrows
+DELETE FROM revoked_tokens
+WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredRevokedTokens(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredRevokedTokens)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const isTokenRevoked = `-- name: IsTokenRevoked :one
+SELECT EXISTS (
+  SELECT 1 FROM revoked_tokens
+  WHERE id = $1
+)
+`
+
+func (q *Queries) IsTokenRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isTokenRevoked, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const revokeFamilyAccessTokens = `-- name: RevokeFamilyAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.family_id = $1
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING id, username, expires_at, created_at
+`
+
+func (q *Queries) RevokeFamilyAccessTokens(ctx context.Context, familyID uuid.UUID) ([]RevokedToken, error) {
+	rows, err := q.db.Query(ctx, revokeFamilyAccessTokens, familyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RevokedToken{}
+	for rows.Next() {
+		var i RevokedToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeOtherAccessTokens = `-- name: RevokeOtherAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.username = $1
+AND sessions.family_id <> $2
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING id, username, expires_at, created_at
+`
+
+type RevokeOtherAccessTokensParams struct {
+	Username string    `json:"username"`
+	FamilyID uuid.UUID `json:"family_id"`
+}
+
+func (q *Queries) RevokeOtherAccessTokens(ctx context.Context, arg RevokeOtherAccessTokensParams) ([]RevokedToken, error) {
+	rows, err := q.db.Query(ctx, revokeOtherAccessTokens, arg.Username, arg.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RevokedToken{}
+	for rows.Next() {
+		var i RevokedToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeUserAccessTokens = `-- name: RevokeUserAccessTokens :many
+INSERT INTO revoked_tokens (id, username, expires_at)
+SELECT access_token_id, username, access_token_expires_at
+FROM sessions
+WHERE sessions.username = $1
+AND sessions.access_token_expires_at > now()
+ON CONFLICT (id) DO NOTHING
+RETURNING id, username, expires_at, created_at
+`
+
+func (q *Queries) RevokeUserAccessTokens(ctx context.Context, username string) ([]RevokedToken, error) {
+	rows, err := q.db.Query(ctx, revokeUserAccessTokens, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RevokedToken{}
+	for rows.Next() {
+		var i RevokedToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,13 @@
+package db
+
+// Statuses of scheduled transfers
+const (
+	// ScheduledTransferStatusActive transfers run when they come due
+	ScheduledTransferStatusActive = "active"
+	// ScheduledTransferStatusCompleted one-off transfers have run
+	ScheduledTransferStatusCompleted = "completed"
+	// ScheduledTransferStatusFailed one-off transfers ran out of attempts
+	ScheduledTransferStatusFailed = "failed"
+	// ScheduledTransferStatusCancelled transfers were cancelled by their owner
+	ScheduledTransferStatusCancelled = "cancelled"
+)
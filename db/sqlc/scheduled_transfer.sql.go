@@ -0,0 +1,350 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: scheduled_transfer.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const cancelScheduledTransfer = `-- name: CancelScheduledTransfer :one
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE id = $1
+AND owner = $2
+AND status = 'active'
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at
+`
+
+type CancelScheduledTransferParams struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+}
+
+func (q *Queries) CancelScheduledTransfer(ctx context.Context, arg CancelScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, cancelScheduledTransfer, arg.ID, arg.Owner)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.DayOfMonth,
+		&i.NextRunAt,
+		&i.Status,
+		&i.FailedAttempts,
+		&i.LastError,
+		&i.LastTransferID,
+		&i.LastRunAt,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const cancelScheduledTransfersByAccount = `-- name: CancelScheduledTransfersByAccount :exec
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE (from_account_id = $1 OR to_account_id = $1)
+AND status = 'active'
+`
+
+func (q *Queries) CancelScheduledTransfersByAccount(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, cancelScheduledTransfersByAccount, accountID)
+	return err
+}
+
+const claimDueScheduledTransfers = `-- name: ClaimDueScheduledTransfers :many
+UPDATE scheduled_transfers
+SET claimed_until = $1
+WHERE id IN (
+  SELECT id FROM scheduled_transfers
+  WHERE status = 'active'
+  AND next_run_at <= now()
+  AND claimed_until <= now()
+  ORDER BY next_run_at
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at
+`
+
+type ClaimDueScheduledTransfersParams struct {
+	ClaimedUntil time.Time `json:"claimed_until"`
+	Limit        int32     `json:"limit"`
+}
+
+func (q *Queries) ClaimDueScheduledTransfers(ctx context.Context, arg ClaimDueScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, claimDueScheduledTransfers, arg.ClaimedUntil, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Frequency,
+			&i.DayOfMonth,
+			&i.NextRunAt,
+			&i.Status,
+			&i.FailedAttempts,
+			&i.LastError,
+			&i.LastTransferID,
+			&i.LastRunAt,
+			&i.ClaimedUntil,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createScheduledTransfer = `-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  owner,
+  from_account_id,
+  to_account_id,
+  amount,
+  frequency,
+  day_of_month,
+  next_run_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at
+`
+
+type CreateScheduledTransferParams struct {
+	Owner         string    `json:"owner"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	Frequency     string    `json:"frequency"`
+	DayOfMonth    int32     `json:"day_of_month"`
+	NextRunAt     time.Time `json:"next_run_at"`
+}
+
+func (q *Queries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, createScheduledTransfer,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Frequency,
+		arg.DayOfMonth,
+		arg.NextRunAt,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.DayOfMonth,
+		&i.NextRunAt,
+		&i.Status,
+		&i.FailedAttempts,
+		&i.LastError,
+		&i.LastTransferID,
+		&i.LastRunAt,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getScheduledTransfer = `-- name: GetScheduledTransfer :one
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at FROM scheduled_transfers
+WHERE id = $1
+AND owner = $2
+LIMIT 1
+`
+
+type GetScheduledTransferParams struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+}
+
+func (q *Queries) GetScheduledTransfer(ctx context.Context, arg GetScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, getScheduledTransfer, arg.ID, arg.Owner)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.DayOfMonth,
+		&i.NextRunAt,
+		&i.Status,
+		&i.FailedAttempts,
+		&i.LastError,
+		&i.LastTransferID,
+		&i.LastRunAt,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listScheduledTransfers = `-- name: ListScheduledTransfers :many
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at FROM scheduled_transfers
+WHERE id > $1
+AND owner = $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListScheduledTransfersParams struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+	Limit int32  `json:"limit"`
+}
+
+func (q *Queries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, listScheduledTransfers, arg.ID, arg.Owner, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Frequency,
+			&i.DayOfMonth,
+			&i.NextRunAt,
+			&i.Status,
+			&i.FailedAttempts,
+			&i.LastError,
+			&i.LastTransferID,
+			&i.LastRunAt,
+			&i.ClaimedUntil,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordScheduledTransferRun = `-- name: RecordScheduledTransferRun :one
+UPDATE scheduled_transfers
+SET
+  next_run_at = $1,
+  status = $2,
+  failed_attempts = $3,
+  last_error = $4,
+  last_transfer_id = $5,
+  last_run_at = now(),
+  claimed_until = $6
+WHERE id = $7
+AND status = 'active'
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at
+`
+
+type RecordScheduledTransferRunParams struct {
+	NextRunAt      time.Time `json:"next_run_at"`
+	Status         string    `json:"status"`
+	FailedAttempts int32     `json:"failed_attempts"`
+	LastError      string    `json:"last_error"`
+	LastTransferID int64     `json:"last_transfer_id"`
+	ClaimedUntil   time.Time `json:"claimed_until"`
+	ID             int64     `json:"id"`
+}
+
+func (q *Queries) RecordScheduledTransferRun(ctx context.Context, arg RecordScheduledTransferRunParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, recordScheduledTransferRun,
+		arg.NextRunAt,
+		arg.Status,
+		arg.FailedAttempts,
+		arg.LastError,
+		arg.LastTransferID,
+		arg.ClaimedUntil,
+		arg.ID,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.DayOfMonth,
+		&i.NextRunAt,
+		&i.Status,
+		&i.FailedAttempts,
+		&i.LastError,
+		&i.LastTransferID,
+		&i.LastRunAt,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateScheduledTransferAmount = `-- name: UpdateScheduledTransferAmount :one
+UPDATE scheduled_transfers
+SET amount = $1
+WHERE id = $2
+AND owner = $3
+AND status = 'active'
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, day_of_month, next_run_at, status, failed_attempts, last_error, last_transfer_id, last_run_at, claimed_until, created_at
+`
+
+type UpdateScheduledTransferAmountParams struct {
+	Amount int64  `json:"amount"`
+	ID     int64  `json:"id"`
+	Owner  string `json:"owner"`
+}
+
+func (q *Queries) UpdateScheduledTransferAmount(ctx context.Context, arg UpdateScheduledTransferAmountParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, updateScheduledTransferAmount, arg.Amount, arg.ID, arg.Owner)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.DayOfMonth,
+		&i.NextRunAt,
+		&i.Status,
+		&i.FailedAttempts,
+		&i.LastError,
+		&i.LastTransferID,
+		&i.LastRunAt,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func createRandomScheduledTransfer(t *testing.T, nextRunAt time.Time) ScheduledTransfer {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	arg := CreateScheduledTransferParams{
+		Owner:         account1.Owner,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        util.RandomInt(1, 100),
+		Frequency:     util.MonthlyFrequency,
+		DayOfMonth:    int32(util.RandomInt(1, 31)),
+		NextRunAt:     nextRunAt,
+	}
+
+	scheduledTransfer, err := testStore.CreateScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, scheduledTransfer.ID)
+
+	require.Equal(t, arg.Owner, scheduledTransfer.Owner)
+	require.Equal(t, arg.FromAccountID, scheduledTransfer.FromAccountID)
+	require.Equal(t, arg.ToAccountID, scheduledTransfer.ToAccountID)
+	require.Equal(t, arg.Amount, scheduledTransfer.Amount)
+	require.Equal(t, arg.Frequency, scheduledTransfer.Frequency)
+	require.Equal(t, arg.DayOfMonth, scheduledTransfer.DayOfMonth)
+	require.WithinDuration(t, arg.NextRunAt, scheduledTransfer.NextRunAt, time.Second)
+	require.Equal(t, ScheduledTransferStatusActive, scheduledTransfer.Status)
+	require.Zero(t, scheduledTransfer.FailedAttempts)
+
+	return scheduledTransfer
+}
+
+func TestCreateScheduledTransfer(t *testing.T) {
+	createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+}
+
+func TestCancelScheduledTransfer(t *testing.T) {
+	scheduledTransfer := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+
+	arg := CancelScheduledTransferParams{
+		ID:    scheduledTransfer.ID,
+		Owner: scheduledTransfer.Owner,
+	}
+
+	cancelled, err := testStore.CancelScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferStatusCancelled, cancelled.Status)
+
+	// only active transfers can be cancelled or updated
+	_, err = testStore.CancelScheduledTransfer(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.UpdateScheduledTransferAmount(context.Background(), UpdateScheduledTransferAmountParams{
+		ID:     scheduledTransfer.ID,
+		Owner:  scheduledTransfer.Owner,
+		Amount: 10,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestClaimDueScheduledTransfers(t *testing.T) {
+	due := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+	notDue := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+
+	arg := ClaimDueScheduledTransfersParams{
+		ClaimedUntil: time.Now().Add(time.Minute),
+		Limit:        1000,
+	}
+
+	claimed, err := testStore.ClaimDueScheduledTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, containsScheduledTransfer(claimed, due.ID))
+	require.False(t, containsScheduledTransfer(claimed, notDue.ID))
+
+	// a claimed transfer is not handed out again until its claim expires
+	claimed, err = testStore.ClaimDueScheduledTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, containsScheduledTransfer(claimed, due.ID))
+
+	recorded, err := testStore.RecordScheduledTransferRun(context.Background(), RecordScheduledTransferRunParams{
+		ID:             due.ID,
+		NextRunAt:      due.NextRunAt.AddDate(0, 1, 0),
+		Status:         ScheduledTransferStatusActive,
+		FailedAttempts: 0,
+		LastTransferID: 0,
+		ClaimedUntil:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), recorded.LastRunAt, time.Second)
+	require.WithinDuration(t, due.NextRunAt.AddDate(0, 1, 0), recorded.NextRunAt, time.Second)
+
+	// a run that finishes after the transfer was cancelled does not bring it back
+	_, err = testStore.CancelScheduledTransfer(context.Background(), CancelScheduledTransferParams{
+		ID:    due.ID,
+		Owner: due.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.RecordScheduledTransferRun(context.Background(), RecordScheduledTransferRunParams{
+		ID:           due.ID,
+		NextRunAt:    due.NextRunAt.AddDate(0, 2, 0),
+		Status:       ScheduledTransferStatusActive,
+		ClaimedUntil: time.Now(),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func containsScheduledTransfer(scheduledTransfers []ScheduledTransfer, id int64) bool {
+	for _, scheduledTransfer := range scheduledTransfers {
+		if scheduledTransfer.ID == id {
+			return true
+		}
+	}
+	return false
+}
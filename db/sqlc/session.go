@@ -0,0 +1,40 @@
+package db
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSessionBlocked       = errors.New("blocked session")
+	ErrSessionRotated       = errors.New("refresh token has already been used")
+	ErrSessionUserMismatch  = errors.New("incorrect session user")
+	ErrSessionTokenMismatch = errors.New("mismatched session token")
+	ErrSessionExpired       = errors.New("expired session")
+)
+
+// Validate checks that the session can be renewed with the given refresh token by the given user.
+// ErrSessionRotated means the refresh token was replaced already, so it is being reused.
+func (session Session) Validate(username, refreshToken string) error {
+	if session.IsBlocked {
+		return ErrSessionBlocked
+	}
+
+	if session.IsRotated {
+		return ErrSessionRotated
+	}
+
+	if session.Username != username {
+		return ErrSessionUserMismatch
+	}
+
+	if session.RefreshToken != refreshToken {
+		return ErrSessionTokenMismatch
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return ErrSessionExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func createRandomSession(t *testing.T, username string) Session {
+	id := uuid.New()
+	arg := CreateSessionParams{
+		ID:                   id,
+		FamilyID:             id,
+		Username:             username,
+		RefreshToken:         util.RandomString(32),
+		UserAgent:            util.RandomString(10),
+		ClientIp:             "127.0.0.1",
+		IsBlocked:            false,
+		ExpiresAt:            time.Now().Add(time.Hour),
+		AccessTokenID:        uuid.New(),
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	session, err := testStore.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.Username, session.Username)
+	require.False(t, session.IsBlocked)
+
+	return session
+}
+
+func TestListActiveSessions(t *testing.T) {
+	user := CreateRandomUser(t)
+	session1 := createRandomSession(t, user.Username)
+	session2 := createRandomSession(t, user.Username)
+
+	_, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session1.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session2.ID, sessions[0].ID)
+}
+
+func TestBlockSession(t *testing.T) {
+	user := CreateRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	// a session can only be blocked by its owner
+	_, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: CreateRandomUser(t).Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	blocked, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+}
+
+func TestBlockOtherSessions(t *testing.T) {
+	user := CreateRandomUser(t)
+	current := createRandomSession(t, user.Username)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user.Username)
+	}
+
+	n, err := testStore.BlockOtherSessions(context.Background(), BlockOtherSessionsParams{
+		Username: user.Username,
+		FamilyID: current.FamilyID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, current.ID, sessions[0].ID)
+}
+
+func TestRotateSessionTx(t *testing.T) {
+	user := CreateRandomUser(t)
+	session1 := createRandomSession(t, user.Username)
+
+	newSession := CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     user.Username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    session1.UserAgent,
+		ClientIp:     session1.ClientIp,
+		ExpiresAt:    session1.ExpiresAt,
+	}
+
+	session2, err := testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  session1.ID,
+		NewSession: newSession,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newSession.ID, session2.ID)
+	require.Equal(t, session1.FamilyID, session2.FamilyID)
+
+	rotated, err := testStore.GetSession(context.Background(), session1.ID)
+	require.NoError(t, err)
+	require.True(t, rotated.IsRotated)
+	require.ErrorIs(t, rotated.Validate(user.Username, session1.RefreshToken), ErrSessionRotated)
+
+	// a session can only be rotated once
+	newSession.ID = uuid.New()
+	_, err = testStore.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  session1.ID,
+		NewSession: newSession,
+	})
+	require.ErrorIs(t, err, ErrSessionRotated)
+
+	err = testStore.BlockSessionFamily(context.Background(), session1.FamilyID)
+	require.NoError(t, err)
+
+	blocked, err := testStore.GetSession(context.Background(), session2.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+}
+
+func TestBlockSessionTxRevokesAccessTokens(t *testing.T) {
+	user := CreateRandomUser(t)
+	session := createRandomSession(t, user.Username)
+	other := createRandomSession(t, user.Username)
+
+	result, err := testStore.BlockSessionTx(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Session.IsBlocked)
+	require.Len(t, result.RevokedTokens, 1)
+	require.Equal(t, session.AccessTokenID, result.RevokedTokens[0].ID)
+	require.WithinDuration(t, session.AccessTokenExpiresAt, result.RevokedTokens[0].ExpiresAt, time.Second)
+
+	revoked, err := testStore.IsTokenRevoked(context.Background(), session.AccessTokenID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = testStore.IsTokenRevoked(context.Background(), other.AccessTokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestBlockOtherSessionsTxRevokesAccessTokens(t *testing.T) {
+	user := CreateRandomUser(t)
+	current := createRandomSession(t, user.Username)
+	other := createRandomSession(t, user.Username)
+
+	result, err := testStore.BlockOtherSessionsTx(context.Background(), BlockOtherSessionsParams{
+		Username: user.Username,
+		FamilyID: current.FamilyID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.BlockedCount)
+	require.Len(t, result.RevokedTokens, 1)
+	require.Equal(t, other.AccessTokenID, result.RevokedTokens[0].ID)
+}
+
+func TestDeleteExpiredRevokedTokens(t *testing.T) {
+	user := CreateRandomUser(t)
+	id := uuid.New()
+	session, err := testStore.CreateSession(context.Background(), CreateSessionParams{
+		ID:                   id,
+		FamilyID:             id,
+		Username:             user.Username,
+		RefreshToken:         util.RandomString(32),
+		UserAgent:            util.RandomString(10),
+		ClientIp:             "127.0.0.1",
+		ExpiresAt:            time.Now().Add(time.Hour),
+		AccessTokenID:        uuid.New(),
+		AccessTokenExpiresAt: time.Now().Add(time.Second),
+	})
+	require.NoError(t, err)
+
+	revokedTokens, err := testStore.BlockSessionFamilyTx(context.Background(), session.FamilyID)
+	require.NoError(t, err)
+	require.Len(t, revokedTokens, 1)
+
+	time.Sleep(time.Second)
+	_, err = testStore.DeleteExpiredRevokedTokens(context.Background())
+	require.NoError(t, err)
+
+	revoked, err := testStore.IsTokenRevoked(context.Background(), session.AccessTokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
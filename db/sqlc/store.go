@@ -3,27 +3,55 @@ package db
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/util"
 )
 
 // Store provides all functions to execute db queries and transactions
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	DepositTx(ctx context.Context, arg ExternalTxParams) (ExternalTxResult, error)
+	WithdrawTx(ctx context.Context, arg ExternalTxParams) (ExternalTxResult, error)
+	AuthorizeHoldTx(ctx context.Context, arg AuthorizeHoldTxParams) (HoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	VoidHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error)
+	ExpireHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error)
+	UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (UpdateAccountStatusTxResult, error)
+	VerifyLedger(ctx context.Context) (LedgerReport, error)
+	StatementTx(ctx context.Context, arg StatementTxParams) (StatementTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	EnableTotpTx(ctx context.Context, arg EnableTotpTxParams) (EnableTotpTxResult, error)
+	UpdateUserRoleTx(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	FreezeUserTx(ctx context.Context, username string) (FreezeUserTxResult, error)
+	BlockSessionTx(ctx context.Context, arg BlockSessionParams) (BlockSessionTxResult, error)
+	BlockOtherSessionsTx(ctx context.Context, arg BlockOtherSessionsParams) (BlockOtherSessionsTxResult, error)
+	BlockSessionFamilyTx(ctx context.Context, familyID uuid.UUID) ([]RevokedToken, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
 type SQLStore struct {
 	*Queries
 	connPool *pgxpool.Pool
+	// transferLimits holds the default transfer limits by currency
+	transferLimits map[string]util.TransferLimits
+	// rateProvider converts the outgoing transfers of a user into one currency for the user transfer limits
+	rateProvider fx.RateProvider
 }
 
-// NewStore creates a new Store
-func NewStore(connPool *pgxpool.Pool) Store {
+// NewStore creates a new Store that enforces the given default transfer limits of each currency.
+// The rate provider converts the other currencies a user sends in when the user limits are checked.
+func NewStore(connPool *pgxpool.Pool, transferLimits map[string]util.TransferLimits, rateProvider fx.RateProvider) Store {
 	return &SQLStore{
-		connPool:      connPool,
-		Queries: New(connPool),
+		connPool:       connPool,
+		Queries:        New(connPool),
+		transferLimits: transferLimits,
+		rateProvider:   rateProvider,
 	}
 }
@@ -3,9 +3,11 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
-	_ "github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/util"
 )
 
 func TestTransferTx(t *testing.T) {
@@ -15,7 +17,7 @@ func TestTransferTx(t *testing.T) {
 	// run a concurrent transfer transactions
 
 	n := 20
-	amount := float64(10)
+	amount := int64(10)
 
 	errs := make(chan error)
 	results := make(chan TransferTxResult)
@@ -109,8 +111,393 @@ func TestTransferTx(t *testing.T) {
 	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
 	require.NoError(t, err)
 
-	require.Equal(t, account1.Balance-float64(n)*amount, updatedAccount1.Balance)
-	require.Equal(t, account2.Balance+float64(n)*amount, updatedAccount2.Balance)
+	require.Equal(t, account1.Balance-int64(n)*amount, updatedAccount1.Balance)
+	require.Equal(t, account2.Balance+int64(n)*amount, updatedAccount2.Balance)
+}
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + 1,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+	}
+
+	_, err := testStore.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// nothing must have been written
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+
+	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, updatedAccount2.Balance)
+
+	// the overdraft limit allows the balance to go below zero
+	_, err = testStore.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: 1,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+arg.Amount, result.ToAccount.Balance)
+}
+
+func TestTransferTxIdempotency(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		Sender:         account1.Owner,
+		Recipient:      account2.Owner,
+		IdempotencyKey: util.RandomString(32),
+		RequestHash:    util.RandomString(64),
+	}
+
+	result1, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// a replay returns the original result without moving money again
+	result2, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, result1.Transfer.ID, result2.Transfer.ID)
+	require.Equal(t, result1.FromEntry.ID, result2.FromEntry.ID)
+	require.Equal(t, result1.FromAccount.Balance, result2.FromAccount.Balance)
+
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-arg.Amount, updatedAccount1.Balance)
+
+	// the same key with a different request is rejected
+	arg.Amount = 20
+	arg.RequestHash = util.RandomString(64)
+	_, err = testStore.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestTransferTxCrossCurrency(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency == account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1000,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		ToAmount:      920,
+		ExchangeRate:  "0.92",
+	}
+
+	result, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, arg.Amount, result.Transfer.Amount)
+	require.Equal(t, arg.ToAmount, result.Transfer.ToAmount)
+	rate, err := result.Transfer.ExchangeRate.Float64Value()
+	require.NoError(t, err)
+	require.Equal(t, 0.92, rate.Float64)
+
+	require.Equal(t, -arg.Amount, result.FromEntry.Amount)
+	require.Equal(t, arg.ToAmount, result.ToEntry.Amount)
+	require.Equal(t, account1.Balance-arg.Amount, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+arg.ToAmount, result.ToAccount.Balance)
+
+	// a conversion that rounded down to nothing is refused rather than credited at a rate of 1
+	arg.ToAmount = 0
+	_, err = testStore.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInvalidConversion)
+}
+
+func TestTransferTxFee(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	createTestFeeRule(t, CreateFeeRuleParams{
+		Currency:     account1.Currency,
+		TransferType: TransferTypeScheduled,
+		FlatFee:      5,
+		BasisPoints:  100,
+	})
+
+	feesAccount, err := testStore.GetSystemAccount(context.Background(), GetSystemAccountParams{
+		Kind:     AccountKindFees,
+		Currency: account1.Currency,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        500,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		Scheduled:     true,
+	})
+	require.NoError(t, err)
+
+	fee := int64(10)
+	require.Equal(t, fee, result.Transfer.Fee)
+	require.Equal(t, account1.Balance-500-fee, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+500, result.ToAccount.Balance)
+	require.Equal(t, int64(-500), result.FromEntry.Amount)
+	require.Equal(t, account1.ID, result.FeeEntry.AccountID)
+	require.Equal(t, -fee, result.FeeEntry.Amount)
+	require.Equal(t, result.Transfer.JournalID, result.FeeEntry.JournalID)
+
+	updatedFeesAccount, err := testStore.GetAccount(context.Background(), feesAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, feesAccount.Balance+fee, updatedFeesAccount.Balance)
+
+	// the same transfer outside a schedule is priced by the internal rules, which charge nothing
+	result, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        500,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Transfer.Fee)
+	require.Empty(t, result.FeeEntry)
+}
+
+func TestTransferTxLimits(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	// an overdraft keeps the balance from getting in the way of the limits
+	_, err := testStore.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: 100000,
+	})
+	require.NoError(t, err)
+
+	// the owner also sends from an account in another currency, worth twice as much
+	otherCurrency := util.USD
+	if account1.Currency == util.USD {
+		otherCurrency = util.EUR
+	}
+	otherAccount, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Balance:  1000,
+		Currency: otherCurrency,
+	})
+	require.NoError(t, err)
+	otherRecipient, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    CreateRandomUser(t).Username,
+		Currency: otherCurrency,
+	})
+	require.NoError(t, err)
+
+	rateProvider, err := fx.NewStaticRateProvider(map[string]string{
+		otherCurrency + "/" + account1.Currency: "2",
+	})
+	require.NoError(t, err)
+
+	store := NewStore(testStore.(*SQLStore).connPool, map[string]util.TransferLimits{
+		account1.Currency: {
+			MaxAmount:    500,
+			AccountDaily: 800,
+			UserMonthly:  1500,
+		},
+	}, rateProvider)
+
+	transfer := func(amount int64) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+			Sender:        account1.Owner,
+			Recipient:     account2.Owner,
+		})
+		return err
+	}
+
+	requireLimitError := func(err error, limit string, remaining int64) {
+		require.ErrorIs(t, err, ErrTransferLimitExceeded)
+
+		var limitErr *TransferLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, limit, limitErr.Limit)
+		require.Equal(t, account1.Currency, limitErr.Currency)
+		require.Equal(t, remaining, limitErr.Remaining)
+	}
+
+	requireLimitError(transfer(501), TransferLimitMaxAmount, 500)
+	require.NoError(t, transfer(500))
+	requireLimitError(transfer(400), TransferLimitAccountDaily, 300)
+	require.NoError(t, transfer(300))
+
+	// the limit set on the account overrides the default of its currency
+	_, err = testStore.UpdateAccountTransferLimits(context.Background(), UpdateAccountTransferLimitsParams{
+		ID:                 account1.ID,
+		DailyTransferLimit: 2000,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, transfer(400))
+
+	// the user limits count what the owner sent in every currency, converted into the currency of the limit
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: otherAccount.ID,
+		ToAccountID:   otherRecipient.ID,
+		Amount:        50,
+		Sender:        otherAccount.Owner,
+		Recipient:     otherRecipient.Owner,
+	})
+	require.NoError(t, err)
+
+	requireLimitError(transfer(400), TransferLimitUserMonthly, 200)
+
+	// the refused transfers were rolled back
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-1200, updatedAccount1.Balance)
+}
+
+func TestTransferTxUserLimitsConcurrent(t *testing.T) {
+	owner := CreateRandomUser(t)
+
+	// the owner sends from two accounts at once, which share no locked account
+	var accounts, recipients []Account
+	for _, currency := range []string{util.USD, util.EUR} {
+		account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    owner.Username,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+
+		account, err = testStore.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+			ID:             account.ID,
+			OverdraftLimit: 100000,
+		})
+		require.NoError(t, err)
+		accounts = append(accounts, account)
+
+		recipient, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    CreateRandomUser(t).Username,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+		recipients = append(recipients, recipient)
+	}
+
+	rateProvider, err := fx.NewStaticRateProvider(map[string]string{
+		util.USD + "/" + util.EUR: "1",
+		util.EUR + "/" + util.USD: "1",
+	})
+	require.NoError(t, err)
+
+	store := NewStore(testStore.(*SQLStore).connPool, map[string]util.TransferLimits{
+		util.USD: {UserDaily: 1000},
+		util.EUR: {UserDaily: 1000},
+	}, rateProvider)
+
+	n := 10
+	amount := int64(200)
+
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		account := accounts[i%2]
+		recipient := recipients[i%2]
+
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account.ID,
+				ToAccountID:   recipient.ID,
+				Amount:        amount,
+				Sender:        account.Owner,
+				Recipient:     recipient.Owner,
+			})
+			errs <- err
+		}()
+	}
+
+	// exactly as many transfers as fit under the user limit go through
+	succeeded := 0
+	for i := 0; i < n; i++ {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+
+		var limitErr *TransferLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, TransferLimitUserDaily, limitErr.Limit)
+	}
+	require.Equal(t, 5, succeeded)
+}
+
+func TestTransferTxLimitsMissingRate(t *testing.T) {
+	owner := CreateRandomUser(t)
+
+	var accounts, recipients []Account
+	for _, currency := range []string{util.USD, util.EUR} {
+		account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    owner.Username,
+			Balance:  1000,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+		accounts = append(accounts, account)
+
+		recipient, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    CreateRandomUser(t).Username,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+		recipients = append(recipients, recipient)
+	}
+
+	rateProvider, err := fx.NewStaticRateProvider(map[string]string{})
+	require.NoError(t, err)
+
+	store := NewStore(testStore.(*SQLStore).connPool, map[string]util.TransferLimits{
+		util.USD: {UserDaily: 1000},
+	}, rateProvider)
+
+	transfer := func(i int) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: accounts[i].ID,
+			ToAccountID:   recipients[i].ID,
+			Amount:        100,
+			Sender:        accounts[i].Owner,
+			Recipient:     recipients[i].Owner,
+		})
+		return err
+	}
+
+	// EUR has no user limits, so the transfer needs no rate
+	require.NoError(t, transfer(1))
+
+	// the EUR sent cannot be counted toward the USD user limit, so the transfer is refused
+	err = transfer(0)
+	require.ErrorIs(t, err, ErrTransferLimitUnchecked)
+	require.ErrorIs(t, err, fx.ErrRateNotFound)
 }
 
 func TestTransferTxDeadlock(t *testing.T) {
@@ -120,7 +507,7 @@ func TestTransferTxDeadlock(t *testing.T) {
 	// run a concurrent transfer transactions
 
 	n := 20
-	amount := float64(10)
+	amount := int64(10)
 
 	errs := make(chan error)
 
@@ -166,3 +553,607 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedAccount1.Balance)
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
+
+func TestDepositTx(t *testing.T) {
+	account := createRandomAccount(t)
+	amount := int64(1000)
+
+	settlementAccount, err := testStore.GetSystemAccount(context.Background(), GetSystemAccountParams{
+		Kind:     AccountKindCash,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, SystemUsername, settlementAccount.Owner)
+
+	arg := ExternalTxParams{
+		AccountID:   account.ID,
+		Amount:      amount,
+		Reference:   util.RandomString(12),
+		Description: "cash deposit",
+	}
+	result, err := testStore.DepositTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, account.Balance+amount, result.Account.Balance)
+	require.Equal(t, amount, result.Entry.Amount)
+	require.Equal(t, arg.Reference, result.Entry.Reference)
+	require.Equal(t, arg.Description, result.Entry.Description)
+
+	require.Equal(t, settlementAccount.ID, result.SettlementAccount.ID)
+	require.Equal(t, -amount, result.SettlementEntry.Amount)
+	require.Empty(t, result.SettlementEntry.Reference)
+
+	// the same external reference is never posted twice to the account
+	_, err = testStore.DepositTx(context.Background(), arg)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	// but other accounts of the currency, which share the cash account, may use it
+	otherAccount := createRandomAccount(t)
+	for otherAccount.Currency != account.Currency {
+		otherAccount = createRandomAccount(t)
+	}
+	arg.AccountID = otherAccount.ID
+	_, err = testStore.DepositTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// money cannot be deposited into the settlement account itself
+	arg.AccountID = settlementAccount.ID
+	arg.Reference = util.RandomString(12)
+	_, err = testStore.DepositTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrSystemAccount)
+}
+
+func TestWithdrawTx(t *testing.T) {
+	account := createRandomAccount(t)
+
+	arg := ExternalTxParams{
+		AccountID:   account.ID,
+		Amount:      account.Balance,
+		Reference:   util.RandomString(12),
+		Description: "wire to external bank",
+	}
+	result, err := testStore.WithdrawTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, result.Account.Balance)
+	require.Equal(t, -account.Balance, result.Entry.Amount)
+	require.Equal(t, account.Balance, result.SettlementEntry.Amount)
+
+	// nothing is left to withdraw, and the failed withdrawal is rolled back
+	arg.Amount = 1
+	arg.Reference = util.RandomString(12)
+	_, err = testStore.WithdrawTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	account, err = testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, account.Balance)
+}
+
+func TestUpdateAccountStatusTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	result, err := testStore.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusActive,
+		ToStatus:   AccountStatusFrozen,
+		Reason:     "suspicious activity",
+		ChangedBy:  account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusFrozen, result.Account.Status)
+	require.Equal(t, account1.ID, result.StatusChange.AccountID)
+	require.Equal(t, AccountStatusActive, result.StatusChange.FromStatus)
+	require.Equal(t, AccountStatusFrozen, result.StatusChange.ToStatus)
+	require.Equal(t, "suspicious activity", result.StatusChange.Reason)
+	require.Equal(t, account2.Owner, result.StatusChange.ChangedBy)
+
+	// money can neither leave nor reach a frozen account
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+		Sender:        account2.Owner,
+		Recipient:     account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrAccountNotActive)
+
+	// a frozen account can only be unfrozen, not closed
+	_, err = testStore.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusFrozen,
+		ToStatus:   AccountStatusClosed,
+		ChangedBy:  account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrAccountStatusChange)
+
+	_, err = testStore.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusFrozen,
+		ToStatus:   AccountStatusActive,
+		Reason:     "cleared by review",
+		ChangedBy:  account2.Owner,
+	})
+	require.NoError(t, err)
+
+	closeArg := UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusActive,
+		ToStatus:   AccountStatusClosed,
+		ChangedBy:  account1.Owner,
+	}
+	_, err = testStore.UpdateAccountStatusTx(context.Background(), closeArg)
+	require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+	_, err = testStore.WithdrawTx(context.Background(), ExternalTxParams{
+		AccountID: account1.ID,
+		Amount:    account1.Balance,
+		Reference: util.RandomString(12),
+	})
+	require.NoError(t, err)
+
+	result, err = testStore.UpdateAccountStatusTx(context.Background(), closeArg)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+
+	// unfreezing cannot reopen a closed account
+	_, err = testStore.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusFrozen,
+		ToStatus:   AccountStatusActive,
+		ChangedBy:  account2.Owner,
+	})
+	require.ErrorIs(t, err, ErrAccountStatusChange)
+
+	result, err = testStore.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account1.ID,
+		FromStatus: AccountStatusClosed,
+		ToStatus:   AccountStatusActive,
+		Reason:     "customer request",
+		ChangedBy:  account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, result.Account.Status)
+
+	statusChanges, err := testStore.ListAccountStatusChanges(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Len(t, statusChanges, 4)
+}
+
+func TestStatementTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	deposit, err := testStore.DepositTx(context.Background(), ExternalTxParams{
+		AccountID: account1.ID,
+		Amount:    1000,
+		Reference: util.RandomString(12),
+	})
+	require.NoError(t, err)
+
+	transfer, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        300,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		ToAmount:      300,
+		ExchangeRate:  "1",
+	})
+	require.NoError(t, err)
+
+	// the opening balance covers the deposit made before the period
+	result, err := testStore.StatementTx(context.Background(), StatementTxParams{
+		AccountID: account1.ID,
+		FromTime:  transfer.FromEntry.CreatedAt,
+		ToTime:    transfer.FromEntry.CreatedAt.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, deposit.Entry.Amount, result.OpeningBalance)
+	require.Len(t, result.Lines, 1)
+
+	line := result.Lines[0]
+	require.Equal(t, transfer.FromEntry.ID, line.Entry.ID)
+	require.Equal(t, JournalKindTransfer, line.JournalKind)
+	require.Equal(t, transfer.Transfer.ID, line.TransferID)
+	require.Equal(t, account2.ID, line.CounterpartyAccountID)
+	require.Equal(t, account2.Owner, line.Counterparty)
+	require.Equal(t, int64(700), line.Balance)
+	require.Equal(t, int64(700), result.ClosingBalance)
+
+	// the recipient sees the sender as counterparty
+	result, err = testStore.StatementTx(context.Background(), StatementTxParams{
+		AccountID: account2.ID,
+		FromTime:  deposit.Entry.CreatedAt,
+		ToTime:    deposit.Entry.CreatedAt.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.OpeningBalance)
+	require.Len(t, result.Lines, 1)
+	require.Equal(t, account1.ID, result.Lines[0].CounterpartyAccountID)
+	require.Equal(t, account1.Owner, result.Lines[0].Counterparty)
+	require.Equal(t, int64(300), result.ClosingBalance)
+
+	_, err = testStore.StatementTx(context.Background(), StatementTxParams{
+		AccountID: account1.ID,
+		FromTime:  deposit.Entry.CreatedAt,
+		ToTime:    deposit.Entry.CreatedAt.Add(MaxStatementPeriod + time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInvalidStatementPeriod)
+}
+
+func TestReverseTransferTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency == account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	original, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1000,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		ToAmount:      920,
+		ExchangeRate:  "0.92",
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusOriginal, original.Transfer.Status)
+
+	// a partial refund is converted back at the original rate, rounded down
+	partial, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     300,
+		Reason:     "damaged goods",
+		ReversedBy: account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account2.ID, partial.Transfer.FromAccountID)
+	require.Equal(t, account1.ID, partial.Transfer.ToAccountID)
+	require.Equal(t, int64(300), partial.Transfer.Amount)
+	require.Equal(t, int64(326), partial.Transfer.ToAmount)
+	require.Equal(t, original.Transfer.ID, partial.Reversal.OriginalTransferID)
+	require.Equal(t, partial.Transfer.ID, partial.Reversal.TransferID)
+	require.Equal(t, TransferStatusPartiallyRefunded, partial.OriginalTransfer.Status)
+	require.Equal(t, int64(300), partial.OriginalTransfer.RefundedAmount)
+
+	journal, err := testStore.GetJournal(context.Background(), partial.Transfer.JournalID)
+	require.NoError(t, err)
+	require.Equal(t, JournalKindReversal, journal.Kind)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     621,
+		ReversedBy: account2.Owner,
+	})
+	require.ErrorIs(t, err, ErrRefundExceedsTransfer)
+
+	// the last refund returns exactly what is left of the original amount
+	rest, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		ReversedBy: account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(620), rest.Transfer.Amount)
+	require.Equal(t, int64(674), rest.Transfer.ToAmount)
+	require.Equal(t, TransferStatusReversed, rest.OriginalTransfer.Status)
+	require.Equal(t, original.Transfer.ToAmount, rest.OriginalTransfer.RefundedAmount)
+	require.Equal(t, original.FromAccount.Balance+original.Transfer.Amount, rest.ToAccount.Balance)
+	require.Equal(t, original.ToAccount.Balance-original.Transfer.ToAmount, rest.FromAccount.Balance)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		ReversedBy: account2.Owner,
+	})
+	require.ErrorIs(t, err, ErrRefundExceedsTransfer)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: rest.Transfer.ID,
+		ReversedBy: account1.Owner,
+	})
+	require.ErrorIs(t, err, ErrReversalNotReversible)
+}
+
+func TestReverseTransferTxRefundTooSmall(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency == account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	original, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		Sender:        account1.Owner,
+		Recipient:     account2.Owner,
+		ToAmount:      9000,
+		ExchangeRate:  "90",
+	})
+	require.NoError(t, err)
+
+	// 50 of the 9000 converts back to less than one minor unit of the sender's currency
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     50,
+		ReversedBy: account2.Owner,
+	})
+	require.ErrorIs(t, err, ErrRefundTooSmall)
+
+	transfer, err := testStore.GetTransferById(context.Background(), original.Transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusOriginal, transfer.Status)
+	require.Zero(t, transfer.RefundedAmount)
+
+	partial, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     90,
+		ReversedBy: account2.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), partial.Transfer.ToAmount)
+}
+
+func createRandomHold(t *testing.T, account1, account2 Account, amount int64) HoldTxResult {
+	arg := AuthorizeHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      amount,
+		Sender:      account1.Owner,
+		Recipient:   account2.Owner,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	result, err := testStore.AuthorizeHoldTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, result.Hold.ID)
+	require.Equal(t, HoldStatusAuthorized, result.Hold.Status)
+	require.Equal(t, arg.Amount, result.Hold.Amount)
+	require.WithinDuration(t, arg.ExpiresAt, result.Hold.ExpiresAt, time.Second)
+	return result
+}
+
+func TestAuthorizeHoldTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	// a capture moves the held amount as it is, so the accounts must share the currency
+	otherAccount := createRandomAccount(t)
+	for otherAccount.Currency == account1.Currency {
+		otherAccount = createRandomAccount(t)
+	}
+
+	invalidHolds := []AuthorizeHoldTxParams{
+		{AccountID: account1.ID, ToAccountID: otherAccount.ID, Amount: 1},
+		{AccountID: account1.ID, ToAccountID: account2.ID, Amount: 0},
+		{AccountID: account1.ID, ToAccountID: account2.ID, Amount: -1},
+	}
+	for _, arg := range invalidHolds {
+		arg.Sender = account1.Owner
+		arg.ExpiresAt = time.Now().Add(time.Hour)
+		_, err := testStore.AuthorizeHoldTx(context.Background(), arg)
+		require.ErrorIs(t, err, ErrInvalidHold)
+	}
+
+	// the hold reserves money without touching the ledger balance
+	result := createRandomHold(t, account1, account2, account1.Balance)
+	require.Equal(t, account1.Balance, result.Account.Balance)
+	require.Equal(t, account1.Balance, result.Account.HeldBalance)
+	require.Zero(t, result.Account.AvailableBalance)
+
+	_, err := testStore.AuthorizeHoldTx(context.Background(), AuthorizeHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      1,
+		Sender:      account1.Owner,
+		Recipient:   account2.Owner,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// transfers and withdrawals can only spend the available balance
+	_, err = testStore.WithdrawTx(context.Background(), ExternalTxParams{
+		AccountID: account1.ID,
+		Amount:    1,
+		Reference: util.RandomString(12),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	voided, err := testStore.VoidHoldTx(context.Background(), result.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusVoided, voided.Hold.Status)
+	require.Zero(t, voided.Account.HeldBalance)
+	require.Equal(t, account1.Balance, voided.Account.AvailableBalance)
+
+	_, err = testStore.VoidHoldTx(context.Background(), result.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotAuthorized)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	hold := createRandomHold(t, account1, account2, 100)
+
+	_, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.Hold.ID,
+		Amount: 101,
+	})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	// a partial capture moves part of the hold and releases the rest
+	result, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.Hold.ID,
+		Amount: 60,
+	})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusCaptured, result.Hold.Status)
+	require.Equal(t, int64(60), result.Hold.CapturedAmount)
+	require.Equal(t, result.Transfer.ID, result.Hold.TransferID)
+	require.Equal(t, int64(60), result.Transfer.Amount)
+	require.Equal(t, account1.Balance-60, result.FromAccount.Balance)
+	require.Zero(t, result.FromAccount.HeldBalance)
+	require.Equal(t, account1.Balance-60, result.FromAccount.AvailableBalance)
+	require.Equal(t, account2.Balance+60, result.ToAccount.Balance)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotAuthorized)
+}
+
+func TestCaptureHoldTxFee(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	createTestFeeRule(t, CreateFeeRuleParams{
+		Currency:     account1.Currency,
+		TransferType: TransferTypeInternal,
+		FlatFee:      7,
+	})
+
+	hold := createRandomHold(t, account1, account2, 100)
+
+	// a capture is charged the fee of a transfer, so holds are no way around it
+	result, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.Hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), result.Transfer.Fee)
+	require.Equal(t, int64(-7), result.FeeEntry.Amount)
+	require.Equal(t, account1.Balance-100-7, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+100, result.ToAccount.Balance)
+}
+
+func TestCaptureHoldTxLimits(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	rateProvider, err := fx.NewStaticRateProvider(nil)
+	require.NoError(t, err)
+
+	store := NewStore(testStore.(*SQLStore).connPool, map[string]util.TransferLimits{
+		account1.Currency: {MaxAmount: 50},
+	}, rateProvider)
+
+	hold := createRandomHold(t, account1, account2, 100)
+
+	// a capture is a transfer, so holds are no way around the transfer limits either
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrTransferLimitExceeded)
+
+	stillHeld, err := testStore.GetHold(context.Background(), hold.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusAuthorized, stillHeld.Status)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.Hold.ID, Amount: 50})
+	require.NoError(t, err)
+	require.Equal(t, int64(50), result.Transfer.Amount)
+}
+
+func TestCaptureHoldTxDeadlock(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	for _, account := range []Account{account1, account2} {
+		_, err := testStore.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+			ID:             account.ID,
+			OverdraftLimit: 100000,
+		})
+		require.NoError(t, err)
+	}
+
+	// captures and transfers both lock the fees account along with the customer accounts
+	createTestFeeRule(t, CreateFeeRuleParams{
+		Currency:     account1.Currency,
+		TransferType: TransferTypeInternal,
+		FlatFee:      1,
+	})
+
+	n := 10
+	amount := int64(10)
+
+	holds := make([]HoldTxResult, n)
+	for i := range holds {
+		holds[i] = createRandomHold(t, account1, account2, amount)
+	}
+
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		holdID := holds[i].Hold.ID
+
+		go func() {
+			_, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: holdID})
+			errs <- err
+		}()
+
+		go func() {
+			_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account2.ID,
+				ToAccountID:   account1.ID,
+				Amount:        amount,
+				Sender:        account2.Owner,
+				Recipient:     account1.Owner,
+			})
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < 2*n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+	}
+
+	// the money went back and forth, and each side paid a fee per transfer
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-int64(n), updatedAccount1.Balance)
+	require.Zero(t, updatedAccount1.HeldBalance)
+
+	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance-int64(n), updatedAccount2.Balance)
+}
+
+func TestExpireHoldTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	for account2.Currency != account1.Currency {
+		account2 = createRandomAccount(t)
+	}
+
+	hold := createRandomHold(t, account1, account2, 100)
+
+	expired, err := testStore.ExpireHoldTx(context.Background(), hold.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusExpired, expired.Hold.Status)
+	require.Zero(t, expired.Account.HeldBalance)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotAuthorized)
+}
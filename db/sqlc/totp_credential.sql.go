@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: totp_credential.sql
+
+package db
+
+import (
+	"context"
+)
+
+const enableTotpCredential = `-- name: EnableTotpCredential :one
+UPDATE totp_credentials
+SET
+    is_enabled = TRUE,
+    last_used_counter = $1
+WHERE
+    username = $2
+    AND is_enabled = FALSE
+RETURNING username, secret, is_enabled, last_used_counter, created_at
+`
+
+type EnableTotpCredentialParams struct {
+	LastUsedCounter int64  `json:"last_used_counter"`
+	Username        string `json:"username"`
+}
+
+func (q *Queries) EnableTotpCredential(ctx context.Context, arg EnableTotpCredentialParams) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, enableTotpCredential, arg.LastUsedCounter, arg.Username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.LastUsedCounter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTotpCredential = `-- name: GetTotpCredential :one
+SELECT username, secret, is_enabled, last_used_counter, created_at FROM totp_credentials
+WHERE username = $1 LIMIT 1
+`
+
+func (q *Queries) GetTotpCredential(ctx context.Context, username string) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, getTotpCredential, username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.LastUsedCounter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateTotpLastUsedCounter = `-- name: UpdateTotpLastUsedCounter :one
+UPDATE totp_credentials
+SET
+    last_used_counter = $1
+WHERE
+    username = $2
+    AND is_enabled = TRUE
+    AND last_used_counter < $1
+RETURNING username, secret, is_enabled, last_used_counter, created_at
+`
+
+type UpdateTotpLastUsedCounterParams struct {
+	LastUsedCounter int64  `json:"last_used_counter"`
+	Username        string `json:"username"`
+}
+
+func (q *Queries) UpdateTotpLastUsedCounter(ctx context.Context, arg UpdateTotpLastUsedCounterParams) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, updateTotpLastUsedCounter, arg.LastUsedCounter, arg.Username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.LastUsedCounter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertTotpCredential = `-- name: UpsertTotpCredential :one
+INSERT INTO totp_credentials (
+    username,
+    secret
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (username) DO UPDATE
+SET
+    secret = EXCLUDED.secret,
+    last_used_counter = 0,
+    created_at = now()
+WHERE
+    totp_credentials.is_enabled = FALSE
+RETURNING username, secret, is_enabled, last_used_counter, created_at
+`
+
+type UpsertTotpCredentialParams struct {
+	Username string `json:"username"`
+	Secret   string `json:"secret"`
+}
+
+func (q *Queries) UpsertTotpCredential(ctx context.Context, arg UpsertTotpCredentialParams) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, upsertTotpCredential, arg.Username, arg.Secret)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.IsEnabled,
+		&i.LastUsedCounter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func createRandomTotpCredential(t *testing.T, username string) TotpCredential {
+	arg := UpsertTotpCredentialParams{
+		Username: username,
+		Secret:   util.RandomString(32),
+	}
+
+	credential, err := testStore.UpsertTotpCredential(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, credential.Username)
+	require.Equal(t, arg.Secret, credential.Secret)
+	require.False(t, credential.IsEnabled)
+	require.Zero(t, credential.LastUsedCounter)
+
+	return credential
+}
+
+func TestEnableTotpTx(t *testing.T) {
+	user := CreateRandomUser(t)
+	createRandomTotpCredential(t, user.Username)
+
+	// re-enrolling before confirmation replaces the secret
+	credential := createRandomTotpCredential(t, user.Username)
+
+	arg := EnableTotpTxParams{
+		Username:            user.Username,
+		LastUsedCounter:     util.RandomInt(1, 1000),
+		HashedRecoveryCodes: []string{util.RandomString(64), util.RandomString(64)},
+	}
+
+	result, err := testStore.EnableTotpTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.TotpCredential.IsEnabled)
+	require.Equal(t, credential.Secret, result.TotpCredential.Secret)
+	require.Equal(t, arg.LastUsedCounter, result.TotpCredential.LastUsedCounter)
+	require.Len(t, result.RecoveryCodes, len(arg.HashedRecoveryCodes))
+
+	// an enabled credential can be neither confirmed again nor replaced
+	_, err = testStore.EnableTotpTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.UpsertTotpCredential(context.Background(), UpsertTotpCredentialParams{
+		Username: user.Username,
+		Secret:   util.RandomString(32),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// each recovery code works once
+	useArg := UseRecoveryCodeParams{
+		Username:   user.Username,
+		HashedCode: arg.HashedRecoveryCodes[0],
+	}
+	recoveryCode, err := testStore.UseRecoveryCode(context.Background(), useArg)
+	require.NoError(t, err)
+	require.True(t, recoveryCode.IsUsed)
+
+	_, err = testStore.UseRecoveryCode(context.Background(), useArg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestUpdateTotpLastUsedCounter(t *testing.T) {
+	user := CreateRandomUser(t)
+	createRandomTotpCredential(t, user.Username)
+
+	_, err := testStore.EnableTotpTx(context.Background(), EnableTotpTxParams{
+		Username:        user.Username,
+		LastUsedCounter: 10,
+	})
+	require.NoError(t, err)
+
+	arg := UpdateTotpLastUsedCounterParams{
+		Username:        user.Username,
+		LastUsedCounter: 11,
+	}
+	credential, err := testStore.UpdateTotpLastUsedCounter(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.LastUsedCounter, credential.LastUsedCounter)
+
+	// a code from the same or an earlier time step is a replay
+	_, err = testStore.UpdateTotpLastUsedCounter(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
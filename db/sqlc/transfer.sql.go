@@ -7,6 +7,9 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
@@ -15,18 +18,26 @@ INSERT INTO transfers (
   to_account_id,
   amount,
   sender,
-  recipient
+  recipient,
+  to_amount,
+  exchange_rate,
+  journal_id,
+  fee
 ) VALUES (
-  $1, $2, $3, $4, $5
-) RETURNING id, from_account_id, to_account_id, sender, recipient, amount, created_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64   `json:"from_account_id"`
-	ToAccountID   int64   `json:"to_account_id"`
-	Amount        float64 `json:"amount"`
-	Sender        string  `json:"sender"`
-	Recipient     string  `json:"recipient"`
+	FromAccountID int64          `json:"from_account_id"`
+	ToAccountID   int64          `json:"to_account_id"`
+	Amount        int64          `json:"amount"`
+	Sender        string         `json:"sender"`
+	Recipient     string         `json:"recipient"`
+	ToAmount      int64          `json:"to_amount"`
+	ExchangeRate  pgtype.Numeric `json:"exchange_rate"`
+	JournalID     int64          `json:"journal_id"`
+	Fee           int64          `json:"fee"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
@@ -36,6 +47,10 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		arg.Amount,
 		arg.Sender,
 		arg.Recipient,
+		arg.ToAmount,
+		arg.ExchangeRate,
+		arg.JournalID,
+		arg.Fee,
 	)
 	var i Transfer
 	err := row.Scan(
@@ -46,12 +61,18 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.Recipient,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.JournalID,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.Fee,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
 WHERE id = $1
 AND (sender = $2
 OR recipient = $2)
@@ -74,12 +95,71 @@ func (q *Queries) GetTransfer(ctx context.Context, arg GetTransferParams) (Trans
 		&i.Recipient,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.JournalID,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.Fee,
+	)
+	return i, err
+}
+
+const getTransferById = `-- name: GetTransferById :one
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferById(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferById, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Sender,
+		&i.Recipient,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.JournalID,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.Fee,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Sender,
+		&i.Recipient,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.JournalID,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.Fee,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
 WHERE id > $1
 AND sender = $2
 ORDER BY id
@@ -109,6 +189,12 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.Recipient,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.JournalID,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -121,7 +207,7 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 }
 
 const listTransfersByFromAccount = `-- name: ListTransfersByFromAccount :many
-SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
 WHERE from_account_id = $1
 AND id > $2
 AND sender = $3
@@ -158,6 +244,12 @@ func (q *Queries) ListTransfersByFromAccount(ctx context.Context, arg ListTransf
 			&i.Recipient,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.JournalID,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -170,7 +262,7 @@ func (q *Queries) ListTransfersByFromAccount(ctx context.Context, arg ListTransf
 }
 
 const listTransfersByFromAndToAccount = `-- name: ListTransfersByFromAndToAccount :many
-SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
 WHERE from_account_id = $1
 AND to_account_id = $2
 AND id > $3
@@ -210,6 +302,12 @@ func (q *Queries) ListTransfersByFromAndToAccount(ctx context.Context, arg ListT
 			&i.Recipient,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.JournalID,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -222,7 +320,7 @@ func (q *Queries) ListTransfersByFromAndToAccount(ctx context.Context, arg ListT
 }
 
 const listTransfersByToAccount = `-- name: ListTransfersByToAccount :many
-SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee FROM transfers
 WHERE to_account_id = $1
 AND id > $2
 AND sender = $3
@@ -259,6 +357,12 @@ func (q *Queries) ListTransfersByToAccount(ctx context.Context, arg ListTransfer
 			&i.Recipient,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.JournalID,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -269,3 +373,105 @@ func (q *Queries) ListTransfersByToAccount(ctx context.Context, arg ListTransfer
 	}
 	return items, nil
 }
+
+const sumOutgoingTransfersByAccount = `-- name: SumOutgoingTransfersByAccount :one
+SELECT COALESCE(SUM(amount), 0)::bigint FROM transfers
+WHERE transfers.from_account_id = $1
+AND transfers.created_at >= $2
+AND NOT EXISTS (
+  SELECT 1 FROM transfer_reversals
+  WHERE transfer_reversals.transfer_id = transfers.id
+)
+`
+
+type SumOutgoingTransfersByAccountParams struct {
+	FromAccountID int64     `json:"from_account_id"`
+	Since         time.Time `json:"since"`
+}
+
+func (q *Queries) SumOutgoingTransfersByAccount(ctx context.Context, arg SumOutgoingTransfersByAccountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, sumOutgoingTransfersByAccount, arg.FromAccountID, arg.Since)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const sumOutgoingTransfersByOwner = `-- name: SumOutgoingTransfersByOwner :many
+SELECT accounts.currency, COALESCE(SUM(transfers.amount), 0)::bigint AS total FROM transfers
+JOIN accounts ON accounts.id = transfers.from_account_id
+WHERE accounts.owner = $1
+AND accounts.kind = 'customer'
+AND transfers.created_at >= $2
+AND NOT EXISTS (
+  SELECT 1 FROM transfer_reversals
+  WHERE transfer_reversals.transfer_id = transfers.id
+)
+GROUP BY accounts.currency
+ORDER BY accounts.currency
+`
+
+type SumOutgoingTransfersByOwnerParams struct {
+	Owner string    `json:"owner"`
+	Since time.Time `json:"since"`
+}
+
+type SumOutgoingTransfersByOwnerRow struct {
+	Currency string `json:"currency"`
+	Total    int64  `json:"total"`
+}
+
+func (q *Queries) SumOutgoingTransfersByOwner(ctx context.Context, arg SumOutgoingTransfersByOwnerParams) ([]SumOutgoingTransfersByOwnerRow, error) {
+	rows, err := q.db.Query(ctx, sumOutgoingTransfersByOwner, arg.Owner, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SumOutgoingTransfersByOwnerRow{}
+	for rows.Next() {
+		var i SumOutgoingTransfersByOwnerRow
+		if err := rows.Scan(&i.Currency, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTransferRefund = `-- name: UpdateTransferRefund :one
+UPDATE transfers
+SET
+  refunded_amount = $1,
+  status = $2
+WHERE id = $3
+RETURNING id, from_account_id, to_account_id, sender, recipient, amount, created_at, to_amount, exchange_rate, journal_id, status, refunded_amount, fee
+`
+
+type UpdateTransferRefundParams struct {
+	RefundedAmount int64  `json:"refunded_amount"`
+	Status         string `json:"status"`
+	ID             int64  `json:"id"`
+}
+
+func (q *Queries) UpdateTransferRefund(ctx context.Context, arg UpdateTransferRefundParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, updateTransferRefund, arg.RefundedAmount, arg.Status, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Sender,
+		&i.Recipient,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.JournalID,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.Fee,
+	)
+	return i, err
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/util"
+)
+
+// Transfer limits a transfer can exceed, see util.TransferLimits
+const (
+	TransferLimitMaxAmount      = "max_amount"
+	TransferLimitAccountDaily   = "account_daily"
+	TransferLimitAccountMonthly = "account_monthly"
+	TransferLimitUserDaily      = "user_daily"
+	TransferLimitUserMonthly    = "user_monthly"
+)
+
+// ErrTransferLimitExceeded is returned, as a *TransferLimitError, when a transfer would exceed a transfer limit
+var ErrTransferLimitExceeded = errors.New("transfer limit exceeded")
+
+// ErrTransferLimitUnchecked is returned when the user transfer limits cannot be checked because there is no
+// exchange rate for a currency the owner sent in. The transfer is refused rather than let through unchecked.
+var ErrTransferLimitUnchecked = errors.New("transfer limits cannot be checked")
+
+// TransferLimitError tells which transfer limit a transfer would exceed and how much is left of it
+type TransferLimitError struct {
+	Limit    string `json:"limit"`
+	Currency string `json:"currency"`
+	// Remaining may still be sent under the limit, in minor units of Currency
+	Remaining int64 `json:"remaining"`
+}
+
+func (err *TransferLimitError) Error() string {
+	return fmt.Sprintf("%s: %s limit has %d %s remaining", ErrTransferLimitExceeded, err.Limit, err.Remaining, err.Currency)
+}
+
+func (err *TransferLimitError) Unwrap() error {
+	return ErrTransferLimitExceeded
+}
+
+// accountTransferLimits returns the transfer limits of an account.
+// The limits set on the account override the defaults of its currency, the user limits are always the defaults.
+func (store *SQLStore) accountTransferLimits(account Account) util.TransferLimits {
+	limits := store.transferLimits[account.Currency]
+	if account.MaxTransferAmount > 0 {
+		limits.MaxAmount = account.MaxTransferAmount
+	}
+	if account.DailyTransferLimit > 0 {
+		limits.AccountDaily = account.DailyTransferLimit
+	}
+	if account.MonthlyTransferLimit > 0 {
+		limits.AccountMonthly = account.MonthlyTransferLimit
+	}
+	return limits
+}
+
+// checkTransferLimits returns a *TransferLimitError if the transfer takes the outgoing transfers of its source account
+// or of the account owner over a limit. The days and months are UTC ones.
+// The user limits of the source account's currency count what the owner sent from all their customer accounts,
+// converted into that currency.
+// It must run after the transfer is created, which counts toward the totals, and while the source account is locked,
+// so that concurrent transfers cannot both squeeze under a limit. The owner is locked too for the user limits,
+// after the accounts so that the lock order stays the same in every transaction.
+func (store *SQLStore) checkTransferLimits(ctx context.Context, q *Queries, account Account, transfer Transfer) error {
+	limits := store.accountTransferLimits(account)
+	if limits.MaxAmount > 0 && transfer.Amount > limits.MaxAmount {
+		return &TransferLimitError{
+			Limit:     TransferLimitMaxAmount,
+			Currency:  account.Currency,
+			Remaining: limits.MaxAmount,
+		}
+	}
+
+	now := transfer.CreatedAt.UTC()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	periods := []struct {
+		limit        string
+		amount       int64
+		since        time.Time
+		accountTotal bool
+	}{
+		{TransferLimitAccountDaily, limits.AccountDaily, startOfDay, true},
+		{TransferLimitAccountMonthly, limits.AccountMonthly, startOfMonth, true},
+		{TransferLimitUserDaily, limits.UserDaily, startOfDay, false},
+		{TransferLimitUserMonthly, limits.UserMonthly, startOfMonth, false},
+	}
+
+	// concurrent transfers from two accounts of the owner share no locked account,
+	// so without the lock both could count only themselves and squeeze under a user limit
+	if limits.UserDaily > 0 || limits.UserMonthly > 0 {
+		if _, err := q.GetUserForUpdate(ctx, account.Owner); err != nil {
+			return err
+		}
+	}
+
+	for _, period := range periods {
+		if period.amount <= 0 {
+			continue
+		}
+
+		var total int64
+		var err error
+		if period.accountTotal {
+			total, err = q.SumOutgoingTransfersByAccount(ctx, SumOutgoingTransfersByAccountParams{
+				FromAccountID: account.ID,
+				Since:         period.since,
+			})
+		} else {
+			total, err = store.sumOutgoingTransfersByOwner(ctx, q, account, period.since)
+		}
+		if err != nil {
+			return err
+		}
+
+		if total > period.amount {
+			remaining := period.amount - (total - transfer.Amount)
+			if remaining < 0 {
+				remaining = 0
+			}
+			return &TransferLimitError{
+				Limit:     period.limit,
+				Currency:  account.Currency,
+				Remaining: remaining,
+			}
+		}
+	}
+
+	return nil
+}
+
+// sumOutgoingTransfersByOwner returns what the owner of the account sent from all their customer accounts since the given time,
+// in minor units of the account's currency. It returns ErrTransferLimitUnchecked if there is no exchange rate
+// for a currency the owner sent in.
+func (store *SQLStore) sumOutgoingTransfersByOwner(ctx context.Context, q *Queries, account Account, since time.Time) (int64, error) {
+	totals, err := q.SumOutgoingTransfersByOwner(ctx, SumOutgoingTransfersByOwnerParams{
+		Owner: account.Owner,
+		Since: since,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var sum int64
+	for _, total := range totals {
+		if total.Currency == account.Currency {
+			sum += total.Total
+			continue
+		}
+
+		conversion, err := fx.Convert(ctx, store.rateProvider, total.Total, total.Currency, account.Currency)
+		if err != nil {
+			// a total worth less than one minor unit of the account's currency adds nothing to the sum
+			if errors.Is(err, fx.ErrAmountTooSmall) {
+				continue
+			}
+			if errors.Is(err, fx.ErrRateNotFound) {
+				return 0, fmt.Errorf("%w: %w", ErrTransferLimitUnchecked, err)
+			}
+			return 0, fmt.Errorf("cannot convert outgoing transfers for the user transfer limits: %w", err)
+		}
+		sum += conversion.ToAmount
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.20.0
+// source: transfer_reversal.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createTransferReversal = `-- name: CreateTransferReversal :one
+INSERT INTO transfer_reversals (
+  transfer_id,
+  original_transfer_id,
+  reason,
+  reversed_by
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING transfer_id, original_transfer_id, reason, reversed_by, created_at
+`
+
+type CreateTransferReversalParams struct {
+	TransferID         int64  `json:"transfer_id"`
+	OriginalTransferID int64  `json:"original_transfer_id"`
+	Reason             string `json:"reason"`
+	ReversedBy         string `json:"reversed_by"`
+}
+
+func (q *Queries) CreateTransferReversal(ctx context.Context, arg CreateTransferReversalParams) (TransferReversal, error) {
+	row := q.db.QueryRow(ctx, createTransferReversal,
+		arg.TransferID,
+		arg.OriginalTransferID,
+		arg.Reason,
+		arg.ReversedBy,
+	)
+	var i TransferReversal
+	err := row.Scan(
+		&i.TransferID,
+		&i.OriginalTransferID,
+		&i.Reason,
+		&i.ReversedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReturnedAmount = `-- name: GetReturnedAmount :one
+SELECT COALESCE(SUM(t.to_amount), 0)::bigint AS returned_amount
+FROM transfer_reversals r
+JOIN transfers t ON t.id = r.transfer_id
+WHERE r.original_transfer_id = $1
+`
+
+func (q *Queries) GetReturnedAmount(ctx context.Context, originalTransferID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, getReturnedAmount, originalTransferID)
+	var returned_amount int64
+	err := row.Scan(&returned_amount)
+	return returned_amount, err
+}
+
+const getTransferReversal = `-- name: GetTransferReversal :one
+SELECT transfer_id, original_transfer_id, reason, reversed_by, created_at FROM transfer_reversals
+WHERE transfer_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferReversal(ctx context.Context, transferID int64) (TransferReversal, error) {
+	row := q.db.QueryRow(ctx, getTransferReversal, transferID)
+	var i TransferReversal
+	err := row.Scan(
+		&i.TransferID,
+		&i.OriginalTransferID,
+		&i.Reason,
+		&i.ReversedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -4,20 +4,30 @@ import (
 	"context"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"github.com/uwemakan/simplebank/util"
 )
 
+func unitExchangeRate(t *testing.T) pgtype.Numeric {
+	var rate pgtype.Numeric
+	require.NoError(t, rate.Scan("1"))
+	return rate
+}
+
 func createRandomTransfer(t *testing.T) Transfer {
 	fromAccount := createRandomAccount(t)
 	toAccount := createRandomAccount(t)
 
+	amount := util.RandomMoney()
 	arg := CreateTransferParams{
 		FromAccountID: fromAccount.ID,
 		ToAccountID:   toAccount.ID,
-		Amount:        util.RandomMoney(),
+		Amount:        amount,
 		Sender:        fromAccount.Owner,
 		Recipient:     toAccount.Owner,
+		ToAmount:      amount,
+		ExchangeRate:  unitExchangeRate(t),
 	}
 
 	a, err := testStore.CreateTransfer(context.Background(), arg)
@@ -27,6 +37,7 @@ func createRandomTransfer(t *testing.T) Transfer {
 	require.Equal(t, fromAccount.ID, a.FromAccountID)
 	require.Equal(t, toAccount.ID, a.ToAccountID)
 	require.Equal(t, arg.Amount, a.Amount)
+	require.Equal(t, arg.ToAmount, a.ToAmount)
 
 	return a
 }
@@ -76,12 +87,15 @@ func createRandomTransfersFromOneAccount(t *testing.T, fromAccountId int64, send
 	for i := 0; i < n; i++ {
 		toAccount := createRandomAccount(t)
 
+		amount := util.RandomMoney()
 		arg := CreateTransferParams{
 			FromAccountID: fromAccountId,
 			ToAccountID:   toAccount.ID,
-			Amount:        util.RandomMoney(),
+			Amount:        amount,
 			Sender:        sender,
 			Recipient:     toAccount.Owner,
+			ToAmount:      amount,
+			ExchangeRate:  unitExchangeRate(t),
 		}
 
 		testStore.CreateTransfer(context.Background(), arg)
@@ -111,12 +125,15 @@ func TestListTransfersByFromAccount(t *testing.T) {
 
 func createRandomTransfersFromAndToTwoAccounts(t *testing.T, fromAccountId int64, toAccountId int64, sender string, recipient string, n int) {
 	for i := 0; i < n; i++ {
+		amount := util.RandomMoney()
 		arg := CreateTransferParams{
 			FromAccountID: fromAccountId,
 			ToAccountID:   toAccountId,
-			Amount:        util.RandomMoney(),
+			Amount:        amount,
 			Sender:        sender,
 			Recipient:     recipient,
+			ToAmount:      amount,
+			ExchangeRate:  unitExchangeRate(t),
 		}
 
 		testStore.CreateTransfer(context.Background(), arg)
@@ -150,12 +167,15 @@ func createRandomTransfersToOneAccount(t *testing.T, toAccountId int64, recipien
 	fromAccount := createRandomAccount(t)
 
 	for i := 0; i < n; i++ {
+		amount := util.RandomMoney()
 		arg := CreateTransferParams{
 			FromAccountID: fromAccount.ID,
 			ToAccountID:   toAccountId,
-			Amount:        util.RandomMoney(),
+			Amount:        amount,
 			Sender:        fromAccount.Owner,
 			Recipient:     recipient,
+			ToAmount:      amount,
+			ExchangeRate:  unitExchangeRate(t),
 		}
 
 		testStore.CreateTransfer(context.Background(), arg)
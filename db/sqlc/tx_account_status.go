@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Statuses of accounts. Only an active account takes part in journals and holds.
+const (
+	AccountStatusActive = "active"
+	AccountStatusFrozen = "frozen"
+	AccountStatusClosed = "closed"
+)
+
+// ErrAccountNotActive is returned when a frozen or closed account would take part in a journal or a hold
+var ErrAccountNotActive = errors.New("account is not active")
+
+// ErrAccountStatusChange is returned when an account cannot move from its status to the requested one
+var ErrAccountStatusChange = errors.New("account status cannot be changed")
+
+// ErrAccountNotEmpty is returned when an account with money or holds on it would be closed
+var ErrAccountNotEmpty = errors.New("account must have a zero balance and no holds to be closed")
+
+// accountStatusChanges lists the statuses each status can move to.
+// Freezing and closing only apply to active accounts, and unfreezing and reopening make them active again.
+var accountStatusChanges = map[string][]string{
+	AccountStatusActive: {AccountStatusFrozen, AccountStatusClosed},
+	AccountStatusFrozen: {AccountStatusActive},
+	AccountStatusClosed: {AccountStatusActive},
+}
+
+// UpdateAccountStatusTxParams contains the input parameters of the update account status transaction
+type UpdateAccountStatusTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// FromStatus is the status the account must be in, so that e.g. unfreezing cannot reopen a closed account
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	ChangedBy  string `json:"changed_by"`
+}
+
+// UpdateAccountStatusTxResult is the result of the update account status transaction
+type UpdateAccountStatusTxResult struct {
+	Account      Account             `json:"account"`
+	StatusChange AccountStatusChange `json:"status_change"`
+}
+
+// UpdateAccountStatusTx moves an account to a new status and records who changed it and why,
+// within a single database transaction. Closing an account cancels its active scheduled transfers.
+// It returns ErrAccountStatusChange if the account is not in FromStatus or cannot move to ToStatus,
+// and ErrAccountNotEmpty if an account to close still has a balance or holds.
+func (store *SQLStore) UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (UpdateAccountStatusTxResult, error) {
+	var result UpdateAccountStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// the lock keeps journals and holds from changing the balance while the account is closed
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Kind != AccountKindCustomer {
+			return ErrSystemAccount
+		}
+
+		if account.Status != arg.FromStatus || !canChangeAccountStatus(account.Status, arg.ToStatus) {
+			return fmt.Errorf("%w from %s to %s", ErrAccountStatusChange, account.Status, arg.ToStatus)
+		}
+
+		if arg.ToStatus == AccountStatusClosed {
+			if account.Balance != 0 || account.HeldBalance != 0 {
+				return ErrAccountNotEmpty
+			}
+
+			if err := q.CancelScheduledTransfersByAccount(ctx, account.ID); err != nil {
+				return err
+			}
+		}
+
+		result.Account, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			ID:     account.ID,
+			Status: arg.ToStatus,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.StatusChange, err = q.CreateAccountStatusChange(ctx, CreateAccountStatusChangeParams{
+			AccountID:  account.ID,
+			FromStatus: account.Status,
+			ToStatus:   arg.ToStatus,
+			Reason:     arg.Reason,
+			ChangedBy:  arg.ChangedBy,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func canChangeAccountStatus(from string, to string) bool {
+	for _, status := range accountStatusChanges[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// activeAccount returns ErrAccountNotActive, naming the account, unless the account is active
+func activeAccount(account Account) error {
+	if account.Status != AccountStatusActive {
+		return fmt.Errorf("%w: account [%d] is %s", ErrAccountNotActive, account.ID, account.Status)
+	}
+	return nil
+}
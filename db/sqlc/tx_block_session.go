@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BlockSessionTxResult is the result of the block session transaction
+type BlockSessionTxResult struct {
+	Session       Session
+	RevokedTokens []RevokedToken
+}
+
+// BlockSessionTx blocks a session and revokes the unexpired access tokens issued in its family
+// within a single database transaction, so that they stop working before they expire
+func (store *SQLStore) BlockSessionTx(ctx context.Context, arg BlockSessionParams) (BlockSessionTxResult, error) {
+	var result BlockSessionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Session, err = q.BlockSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.RevokedTokens, err = q.RevokeFamilyAccessTokens(ctx, result.Session.FamilyID)
+		return err
+	})
+
+	return result, err
+}
+
+// BlockOtherSessionsTxResult is the result of the block other sessions transaction
+type BlockOtherSessionsTxResult struct {
+	BlockedCount  int64
+	RevokedTokens []RevokedToken
+}
+
+// BlockOtherSessionsTx blocks every session of the user outside the given family
+// and revokes their unexpired access tokens within a single database transaction
+func (store *SQLStore) BlockOtherSessionsTx(ctx context.Context, arg BlockOtherSessionsParams) (BlockOtherSessionsTxResult, error) {
+	var result BlockOtherSessionsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.BlockedCount, err = q.BlockOtherSessions(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.RevokedTokens, err = q.RevokeOtherAccessTokens(ctx, RevokeOtherAccessTokensParams{
+			Username: arg.Username,
+			FamilyID: arg.FamilyID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// BlockSessionFamilyTx blocks every session rotated from the same login
+// and revokes their unexpired access tokens within a single database transaction
+func (store *SQLStore) BlockSessionFamilyTx(ctx context.Context, familyID uuid.UUID) ([]RevokedToken, error) {
+	var revokedTokens []RevokedToken
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.BlockSessionFamily(ctx, familyID)
+		if err != nil {
+			return err
+		}
+
+		revokedTokens, err = q.RevokeFamilyAccessTokens(ctx, familyID)
+		return err
+	})
+
+	return revokedTokens, err
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+)
+
+// ExternalTxParams contains the input parameters of the deposit and withdrawal transactions
+type ExternalTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Amount must be positive, in minor units of the account currency
+	Amount int64 `json:"amount"`
+	// Reference identifies the money movement outside the bank, e.g. a wire or a teller receipt.
+	// It can only be posted once per account.
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
+}
+
+// ExternalTxResult is the result of the deposit and withdrawal transactions
+type ExternalTxResult struct {
+	Account Account `json:"account"`
+	Entry   Entry   `json:"entry"`
+	// SettlementAccount is the cash account of the currency
+	SettlementAccount Account `json:"settlement_account"`
+	SettlementEntry   Entry   `json:"settlement_entry"`
+}
+
+// DepositTx credits money that came into the bank to an account, against the cash account
+// of its currency, with a deposit journal within a single database transaction
+func (store *SQLStore) DepositTx(ctx context.Context, arg ExternalTxParams) (ExternalTxResult, error) {
+	return store.externalTx(ctx, arg, JournalKindDeposit, arg.Amount)
+}
+
+// WithdrawTx debits money that leaves the bank from an account, against the cash account of its currency,
+// with a withdrawal journal within a single database transaction. It returns ErrInsufficientFunds
+// if the withdrawal would take the available balance of the account below its overdraft limit.
+func (store *SQLStore) WithdrawTx(ctx context.Context, arg ExternalTxParams) (ExternalTxResult, error) {
+	return store.externalTx(ctx, arg, JournalKindWithdrawal, -arg.Amount)
+}
+
+// externalTx adds amount to the account and subtracts it from the cash account, which settles it
+func (store *SQLStore) externalTx(ctx context.Context, arg ExternalTxParams, kind string, amount int64) (ExternalTxResult, error) {
+	var result ExternalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Kind != AccountKindCustomer {
+			return ErrSystemAccount
+		}
+
+		settlementAccount, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+			Kind:     AccountKindCash,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		// only the customer posting carries the reference, which is unique per account,
+		// so that customers sharing the cash account of a currency cannot collide on it
+		journal, err := postJournal(ctx, q, kind, []Posting{
+			{AccountID: account.ID, Amount: amount, Reference: arg.Reference, Description: arg.Description},
+			{AccountID: settlementAccount.ID, Amount: -amount, Description: arg.Description},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account = journal.Accounts[account.ID]
+		result.Entry = journal.Entries[0]
+		result.SettlementAccount = journal.Accounts[settlementAccount.ID]
+		result.SettlementEntry = journal.Entries[1]
+
+		if amount < 0 && result.Account.AvailableBalance < -result.Account.OverdraftLimit {
+			return ErrInsufficientFunds
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+)
+
+type EnableTotpTxParams struct {
+	Username            string
+	LastUsedCounter     int64
+	HashedRecoveryCodes []string
+}
+
+type EnableTotpTxResult struct {
+	TotpCredential TotpCredential
+	RecoveryCodes  []RecoveryCode
+}
+
+// EnableTotpTx turns on a pending TOTP enrollment and replaces the user's
+// recovery codes within a single database transaction.
+// It returns ErrRecordNotFound if there is no pending enrollment.
+func (store *SQLStore) EnableTotpTx(ctx context.Context, arg EnableTotpTxParams) (EnableTotpTxResult, error) {
+	var result EnableTotpTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.TotpCredential, err = q.EnableTotpCredential(ctx, EnableTotpCredentialParams{
+			Username:        arg.Username,
+			LastUsedCounter: arg.LastUsedCounter,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRecoveryCodes(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		for _, hashedCode := range arg.HashedRecoveryCodes {
+			recoveryCode, err := q.CreateRecoveryCode(ctx, CreateRecoveryCodeParams{
+				Username:   arg.Username,
+				HashedCode: hashedCode,
+			})
+			if err != nil {
+				return err
+			}
+			result.RecoveryCodes = append(result.RecoveryCodes, recoveryCode)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+)
+
+// FreezeUserTxResult is the result of the freeze user transaction
+type FreezeUserTxResult struct {
+	User          User
+	RevokedTokens []RevokedToken
+}
+
+// FreezeUserTx freezes a user, blocks all their sessions and revokes their access tokens
+// within a single database transaction, so that a frozen user is logged out everywhere at once.
+// It returns ErrSystemUser for the system user, which owns the system accounts.
+func (store *SQLStore) FreezeUserTx(ctx context.Context, username string) (FreezeUserTxResult, error) {
+	var result FreezeUserTxResult
+
+	if username == SystemUsername {
+		return result, ErrSystemUser
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.User, err = q.UpdateUserFrozen(ctx, UpdateUserFrozenParams{
+			Username: username,
+			IsFrozen: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.BlockUserSessions(ctx, result.User.Username)
+		if err != nil {
+			return err
+		}
+
+		result.RevokedTokens, err = q.RevokeUserAccessTokens(ctx, result.User.Username)
+		return err
+	})
+
+	return result, err
+}
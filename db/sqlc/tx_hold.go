@@ -0,0 +1,278 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Statuses of holds. Only an authorized hold reserves money.
+const (
+	HoldStatusAuthorized = "authorized"
+	HoldStatusCaptured   = "captured"
+	HoldStatusVoided     = "voided"
+	HoldStatusExpired    = "expired"
+)
+
+// MaxHoldDuration is the longest a hold may reserve money for before it expires
+const MaxHoldDuration = 30 * 24 * time.Hour
+
+// ErrHoldNotAuthorized is returned when a hold was already captured, voided or expired
+var ErrHoldNotAuthorized = errors.New("hold is no longer authorized")
+
+// ErrCaptureExceedsHold is returned when a capture would move more than the held amount
+var ErrCaptureExceedsHold = errors.New("capture exceeds the held amount")
+
+// ErrInvalidHold is returned when a hold is not for a positive amount between accounts of the same currency
+var ErrInvalidHold = errors.New("hold must be for a positive amount between accounts of the same currency")
+
+// AuthorizeHoldTxParams contains the input parameters of the authorize hold transaction
+type AuthorizeHoldTxParams struct {
+	AccountID   int64 `json:"account_id"`
+	ToAccountID int64 `json:"to_account_id"`
+	// Amount is held in minor units of the currency both accounts share
+	Amount    int64     `json:"amount"`
+	Sender    string    `json:"sender"`
+	Recipient string    `json:"recipient"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// HoldTxResult is the result of the hold transactions
+type HoldTxResult struct {
+	Hold Hold `json:"hold"`
+	// Account is the account the money was held on, after its held balance changed
+	Account Account `json:"account"`
+}
+
+// CaptureHoldTxParams contains the input parameters of the capture hold transaction
+type CaptureHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+	// Amount is moved to the recipient, the rest of the hold is released.
+	// If zero, the whole held amount is moved.
+	Amount int64 `json:"amount"`
+}
+
+// CaptureHoldTxResult is the result of the capture hold transaction
+type CaptureHoldTxResult struct {
+	Hold Hold `json:"hold"`
+	// TransferTxResult holds the transfer that moved the captured amount
+	TransferTxResult
+}
+
+// AuthorizeHoldTx reserves money on an account for a later capture, within a single database transaction.
+// The hold lowers the available balance of the account, but not its ledger balance.
+// It returns ErrInvalidHold if the amount is not positive or the accounts have different currencies,
+// ErrAccountNotActive if either account is frozen or closed,
+// and ErrInsufficientFunds if the hold would take the available balance below the overdraft limit.
+func (store *SQLStore) AuthorizeHoldTx(ctx context.Context, arg AuthorizeHoldTxParams) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	if arg.Amount <= 0 {
+		return result, ErrInvalidHold
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// both accounts are locked in the order of their ids, like transfers lock them,
+		// so that neither can be frozen or closed between the checks and the hold
+		if err := lockAccounts(ctx, q, arg.AccountID, arg.ToAccountID); err != nil {
+			return err
+		}
+
+		account, err := q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Kind != AccountKindCustomer || toAccount.Kind != AccountKindCustomer {
+			return ErrSystemAccount
+		}
+
+		// a capture moves the held amount as it is, without converting it
+		if account.Currency != toAccount.Currency {
+			return ErrInvalidHold
+		}
+
+		result.Account, err = q.UpdateAccountHeldBalance(ctx, UpdateAccountHeldBalanceParams{
+			ID:     account.ID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		// the row locks keep these checks from racing with transfers or status changes
+		if err := activeAccount(result.Account); err != nil {
+			return err
+		}
+		if err := activeAccount(toAccount); err != nil {
+			return err
+		}
+		if result.Account.AvailableBalance < -result.Account.OverdraftLimit {
+			return ErrInsufficientFunds
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			AccountID:   arg.AccountID,
+			ToAccountID: arg.ToAccountID,
+			Sender:      arg.Sender,
+			Recipient:   arg.Recipient,
+			Amount:      arg.Amount,
+			ExpiresAt:   arg.ExpiresAt,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// CaptureHoldTx releases a hold and moves all or part of the held amount to the recipient with a transfer,
+// within a single database transaction. A hold can only be captured once, and not after it expired.
+// A capture is priced like a transfer of the captured amount, see TransferFee, and its fee is debited
+// from the sender on top of it, so it returns ErrInsufficientFunds if the fee does not fit the available balance.
+// The captured amount counts toward the transfer limits of the sender, see checkTransferLimits,
+// and it returns a *TransferLimitError, leaving the hold authorized, if it would exceed one.
+// It returns ErrHoldNotAuthorized if the hold was already closed, and ErrCaptureExceedsHold
+// if the amount is more than the hold.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	// a hold is in the currency both accounts share
+	var exchangeRate pgtype.Numeric
+	if err := exchangeRate.Scan("1"); err != nil {
+		return result, err
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+		if err != nil {
+			return err
+		}
+		if hold.Status != HoldStatusAuthorized || !hold.ExpiresAt.After(time.Now()) {
+			return ErrHoldNotAuthorized
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount <= 0 || amount > hold.Amount {
+			return ErrCaptureExceedsHold
+		}
+
+		// the journal of the transfer locks the accounts and the fees account of their currency
+		// in the order of their ids, so all of them are locked the same way before the held balance is released.
+		// The fees account is locked even if the capture turns out to be free, as the fee is only priced later.
+		account, err := q.GetAccount(ctx, hold.AccountID)
+		if err != nil {
+			return err
+		}
+
+		feesAccount, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+			Kind:     AccountKindFees,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := lockAccounts(ctx, q, hold.AccountID, hold.ToAccountID, feesAccount.ID); err != nil {
+			return err
+		}
+
+		_, err = q.UpdateAccountHeldBalance(ctx, UpdateAccountHeldBalanceParams{
+			ID:     hold.AccountID,
+			Amount: -hold.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, JournalKindTransfer, TransferTxParams{
+			FromAccountID: hold.AccountID,
+			ToAccountID:   hold.ToAccountID,
+			Amount:        amount,
+			Sender:        hold.Sender,
+			Recipient:     hold.Recipient,
+			ToAmount:      amount,
+		}, exchangeRate, true)
+		if err != nil {
+			return err
+		}
+
+		if err := store.checkTransferLimits(ctx, q, result.FromAccount, result.Transfer); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CloseHold(ctx, CloseHoldParams{
+			ID:             hold.ID,
+			Status:         HoldStatusCaptured,
+			CapturedAmount: amount,
+			TransferID:     result.Transfer.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// VoidHoldTx releases a hold without moving any money, within a single database transaction.
+// It returns ErrHoldNotAuthorized if the hold was already closed.
+func (store *SQLStore) VoidHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	return store.releaseHoldTx(ctx, holdID, HoldStatusVoided)
+}
+
+// ExpireHoldTx releases a hold that outlived its expiry, within a single database transaction.
+// It returns ErrHoldNotAuthorized if the hold was already closed.
+func (store *SQLStore) ExpireHoldTx(ctx context.Context, holdID int64) (HoldTxResult, error) {
+	return store.releaseHoldTx(ctx, holdID, HoldStatusExpired)
+}
+
+// releaseHoldTx closes an authorized hold with the given status and gives its amount back to the available balance
+func (store *SQLStore) releaseHoldTx(ctx context.Context, holdID int64, status string) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, holdID)
+		if err != nil {
+			return err
+		}
+		if hold.Status != HoldStatusAuthorized {
+			return ErrHoldNotAuthorized
+		}
+
+		result.Account, err = q.UpdateAccountHeldBalance(ctx, UpdateAccountHeldBalanceParams{
+			ID:     hold.AccountID,
+			Amount: -hold.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CloseHold(ctx, CloseHoldParams{
+			ID:     hold.ID,
+			Status: status,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// lockAccounts locks the accounts in the order of their ids, the same order postJournal locks them in
+func lockAccounts(ctx context.Context, q *Queries, accountIDs ...int64) error {
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+	for _, accountID := range accountIDs {
+		if _, err := q.GetAccountForUpdate(ctx, accountID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
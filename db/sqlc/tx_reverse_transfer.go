@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/uwemakan/simplebank/fx"
+)
+
+// Statuses of transfers, by the refunds made against them
+const (
+	TransferStatusOriginal          = "original"
+	TransferStatusReversed          = "reversed"
+	TransferStatusPartiallyRefunded = "partially_refunded"
+)
+
+// ErrRefundExceedsTransfer is returned when a reversal would send back more than the unrefunded amount of a transfer
+var ErrRefundExceedsTransfer = errors.New("refund exceeds the unrefunded amount of the transfer")
+
+// ErrReversalNotReversible is returned when a reversal is attempted on a transfer that is itself a reversal
+var ErrReversalNotReversible = errors.New("a reversal cannot be reversed")
+
+// ErrRefundTooSmall is returned when a partial refund of a cross-currency transfer converts back to nothing
+var ErrRefundTooSmall = errors.New("refund is too small to convert back into the currency of the sender")
+
+// ReverseTransferTxParams contains the input parameters of the reverse transfer transaction
+type ReverseTransferTxParams struct {
+	TransferID int64 `json:"transfer_id"`
+	// Amount is sent back in minor units of the destination account currency of the transfer.
+	// If zero, the whole unrefunded amount is sent back.
+	Amount     int64  `json:"amount"`
+	Reason     string `json:"reason"`
+	ReversedBy string `json:"reversed_by"`
+}
+
+// ReverseTransferTxResult is the result of the reverse transfer transaction
+type ReverseTransferTxResult struct {
+	// TransferTxResult holds the compensating transfer, from the recipient back to the sender
+	TransferTxResult
+	OriginalTransfer Transfer         `json:"original_transfer"`
+	Reversal         TransferReversal `json:"reversal"`
+}
+
+// ReverseTransferTx sends all or part of a transfer back to its sender with a compensating transfer
+// linked to the original one, within a single database transaction.
+// A cross-currency transfer is sent back at its original exchange rate, and the last refund
+// returns exactly what is left of the original amount, so that rounding never leaks money.
+// Reversals are free, and the fee of the original transfer is not refunded.
+// It returns ErrRefundExceedsTransfer if the refunds would add up to more than the transfer,
+// ErrRefundTooSmall if a partial refund rounds down to nothing in the currency of the sender,
+// and ErrInsufficientFunds if the recipient no longer holds the money.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// the lock serializes concurrent refunds of the same transfer
+		original, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetTransferReversal(ctx, original.ID)
+		if err == nil {
+			return ErrReversalNotReversible
+		}
+		if err != ErrRecordNotFound {
+			return err
+		}
+
+		unrefunded := original.ToAmount - original.RefundedAmount
+		amount := arg.Amount
+		if amount == 0 {
+			amount = unrefunded
+		}
+		if amount <= 0 || amount > unrefunded {
+			return ErrRefundExceedsTransfer
+		}
+
+		returnedAmount, err := q.GetReturnedAmount(ctx, original.ID)
+		if err != nil {
+			return err
+		}
+
+		transferArg := TransferTxParams{
+			FromAccountID: original.ToAccountID,
+			ToAccountID:   original.FromAccountID,
+			Amount:        amount,
+			Sender:        original.Recipient,
+			Recipient:     original.Sender,
+			ToAmount:      original.Amount - returnedAmount,
+		}
+		if amount < unrefunded {
+			transferArg.ToAmount = returnAmount(original, amount)
+		}
+		if transferArg.ToAmount <= 0 {
+			return ErrRefundTooSmall
+		}
+
+		exchangeRate, err := inverseExchangeRate(original)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, JournalKindReversal, transferArg, exchangeRate, false)
+		if err != nil {
+			return err
+		}
+
+		result.Reversal, err = q.CreateTransferReversal(ctx, CreateTransferReversalParams{
+			TransferID:         result.Transfer.ID,
+			OriginalTransferID: original.ID,
+			Reason:             arg.Reason,
+			ReversedBy:         arg.ReversedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		status := TransferStatusPartiallyRefunded
+		if amount == unrefunded {
+			status = TransferStatusReversed
+		}
+
+		result.OriginalTransfer, err = q.UpdateTransferRefund(ctx, UpdateTransferRefundParams{
+			ID:             original.ID,
+			RefundedAmount: original.RefundedAmount + amount,
+			Status:         status,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// returnAmount converts a refund in the destination currency of the transfer back into its source currency
+// at the original exchange rate, rounded down so that partial refunds never return more than the transfer
+func returnAmount(original Transfer, amount int64) int64 {
+	returned := new(big.Int).Mul(big.NewInt(amount), big.NewInt(original.Amount))
+	return returned.Quo(returned, big.NewInt(original.ToAmount)).Int64()
+}
+
+// inverseExchangeRate returns the rate that converts the destination currency of the transfer back into its source currency
+func inverseExchangeRate(original Transfer) (pgtype.Numeric, error) {
+	var exchangeRate pgtype.Numeric
+	rate := "1"
+	if original.Amount != original.ToAmount {
+		rate = big.NewRat(original.Amount, original.ToAmount).FloatString(fx.RatePrecision)
+	}
+	err := exchangeRate.Scan(rate)
+	return exchangeRate, err
+}
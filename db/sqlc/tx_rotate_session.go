@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RotateSessionTxParams contains the input parameters of the rotate session transaction
+type RotateSessionTxParams struct {
+	SessionID  uuid.UUID
+	NewSession CreateSessionParams
+}
+
+// RotateSessionTx marks a session as rotated and creates the session of the new refresh token in the same family.
+// It returns ErrSessionRotated if the session was rotated or blocked in the meantime,
+// which means its refresh token was used more than once.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error) {
+	var session Session
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		oldSession, err := q.RotateSession(ctx, arg.SessionID)
+		if err != nil {
+			if err == ErrRecordNotFound {
+				return ErrSessionRotated
+			}
+			return err
+		}
+
+		arg.NewSession.FamilyID = oldSession.FamilyID
+		session, err = q.CreateSession(ctx, arg.NewSession)
+		return err
+	})
+
+	return session, err
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MaxStatementPeriod is the longest period a single statement may cover
+const MaxStatementPeriod = 366 * 24 * time.Hour
+
+// ErrInvalidStatementPeriod is returned when a statement period is empty or longer than MaxStatementPeriod
+var ErrInvalidStatementPeriod = errors.New("statement period must end after it starts and cover at most 366 days")
+
+// StatementTxParams contains the input parameters of the statement transaction
+type StatementTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// FromTime is inclusive and ToTime exclusive
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+// StatementLine is an entry of a statement, with the transfer it came from if any,
+// and the balance of the account after it
+type StatementLine struct {
+	ListStatementEntriesRow
+	Balance int64 `json:"balance"`
+}
+
+// StatementTxResult is the result of the statement transaction
+type StatementTxResult struct {
+	AccountID      int64           `json:"account_id"`
+	FromTime       time.Time       `json:"from_time"`
+	ToTime         time.Time       `json:"to_time"`
+	OpeningBalance int64           `json:"opening_balance"`
+	Lines          []StatementLine `json:"lines"`
+	ClosingBalance int64           `json:"closing_balance"`
+}
+
+// StatementTx lists the entries of an account over a period, with the running balance after each of them.
+// Balances are the sums of the entries, so the opening balance is the sum of every entry before the period.
+func (store *SQLStore) StatementTx(ctx context.Context, arg StatementTxParams) (StatementTxResult, error) {
+	result := StatementTxResult{
+		AccountID: arg.AccountID,
+		FromTime:  arg.FromTime,
+		ToTime:    arg.ToTime,
+	}
+
+	if !arg.ToTime.After(arg.FromTime) || arg.ToTime.Sub(arg.FromTime) > MaxStatementPeriod {
+		return result, ErrInvalidStatementPeriod
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.OpeningBalance, err = q.GetBalanceBefore(ctx, GetBalanceBeforeParams{
+			AccountID:  arg.AccountID,
+			BeforeTime: arg.FromTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.ListStatementEntries(ctx, ListStatementEntriesParams{
+			AccountID: arg.AccountID,
+			FromTime:  arg.FromTime,
+			ToTime:    arg.ToTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		balance := result.OpeningBalance
+		result.Lines = make([]StatementLine, len(rows))
+		for i, row := range rows {
+			balance += row.Entry.Amount
+			result.Lines[i] = StatementLine{
+				ListStatementEntriesRow: row,
+				Balance:                 balance,
+			}
+		}
+		result.ClosingBalance = balance
+
+		return nil
+	})
+
+	return result, err
+}
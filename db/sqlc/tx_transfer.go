@@ -1,14 +1,33 @@
 package db
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrInvalidConversion is returned when a transfer between currencies has no positive converted amount or no exchange rate
+var ErrInvalidConversion = errors.New("transfer between currencies needs a positive converted amount and an exchange rate")
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
-	FromAccountID int64   `json:"from_account_id"`
-	ToAccountID   int64   `json:"to_account_id"`
-	Amount        float64 `json:"amount"`
-	Sender        string  `json:"sender"`
-	Recipient     string  `json:"recipient"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Sender        string `json:"sender"`
+	Recipient     string `json:"recipient"`
+	// ToAmount is credited to the destination account in its own currency, converted at ExchangeRate.
+	// Both are ignored if the accounts share a currency, in which case Amount is credited at a rate of 1.
+	ToAmount     int64  `json:"to_amount"`
+	ExchangeRate string `json:"exchange_rate"`
+	// IdempotencyKey is optional. When set, a replay with the same key returns the stored result.
+	IdempotencyKey string `json:"idempotency_key"`
+	RequestHash    string `json:"request_hash"`
+	// Scheduled marks a run of a scheduled transfer, which is priced by the scheduled fee rules
+	Scheduled bool `json:"scheduled"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -18,83 +37,198 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// FeeEntry debits the fee from the source account. It is empty if the transfer was free.
+	FeeEntry Entry `json:"fee_entry"`
 }
 
 // TransferTx performs a money transfer from one account to the other.
-// It creates a transfer record, add account entries, and update accounts' balance within a single database transaction
+// It posts a transfer journal, creates a transfer record linked to it and updates the accounts' balances
+// within a single database transaction. The source account is debited Amount and the destination account
+// is credited ToAmount, with the FX system accounts taking the other side of a currency conversion.
+// The fee of the transfer, see TransferFee, is debited from the source account on top of Amount
+// and credited to the fees system account of its currency in the same journal.
+// Neither account may be a system account.
+// It returns ErrInvalidConversion if the accounts have different currencies and ToAmount is not positive,
+// ErrAccountNotActive if either account is frozen or closed,
+// ErrInsufficientFunds if the transfer would take the available balance of the source account
+// below its overdraft limit, and a *TransferLimitError if it would exceed a transfer limit of the source account
+// or of its owner, see checkTransferLimits.
+// If an idempotency key is given, it is claimed in the same transaction so a retried request never moves money twice.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
+	var exchangeRate pgtype.Numeric
+	if arg.ExchangeRate != "" {
+		if err := exchangeRate.Scan(arg.ExchangeRate); err != nil {
+			return result, fmt.Errorf("invalid exchange rate %q: %w", arg.ExchangeRate, err)
+		}
+	}
+
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams(arg))
+		if arg.IdempotencyKey != "" {
+			replayed, err := claimIdempotencyKey(ctx, q, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
 
+		result, err = transfer(ctx, q, JournalKindTransfer, arg, exchangeRate, true)
 		if err != nil {
 			return err
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
-
-		if err != nil {
+		if err := store.checkTransferLimits(ctx, q, result.FromAccount, result.Transfer); err != nil {
 			return err
 		}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+		if arg.IdempotencyKey != "" {
+			return storeIdempotentResult(ctx, q, arg, result)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// transfer posts a journal of the given kind that moves money between two customer accounts
+// and creates the transfer record linked to it. If priced, the fee of the transfer is charged too.
+// It must run inside a database transaction.
+func transfer(ctx context.Context, q *Queries, kind string, arg TransferTxParams, exchangeRate pgtype.Numeric, priced bool) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	if fromAccount.Kind != AccountKindCustomer || toAccount.Kind != AccountKindCustomer {
+		return result, ErrSystemAccount
+	}
+
+	// the currencies of the accounts, not the arguments, decide whether money is converted,
+	// so that a conversion rounded down to nothing is never credited at a rate of 1
+	if fromAccount.Currency == toAccount.Currency {
+		arg.ToAmount = arg.Amount
+		exchangeRate = pgtype.Numeric{Int: big.NewInt(1), Valid: true}
+	} else if arg.ToAmount <= 0 || !exchangeRate.Valid {
+		return result, ErrInvalidConversion
+	}
+
+	postings := []Posting{
+		{AccountID: fromAccount.ID, Amount: -arg.Amount},
+		{AccountID: toAccount.ID, Amount: arg.ToAmount},
+	}
 
+	// the FX accounts buy the source currency and sell the destination one,
+	// so that the journal balances in both currencies
+	if fromAccount.Currency != toAccount.Currency {
+		fxPostings, err := fxPostings(ctx, q, fromAccount.Currency, arg.Amount, toAccount.Currency, arg.ToAmount)
 		if err != nil {
-			return err
+			return result, err
 		}
+		postings = append(postings, fxPostings...)
+	}
 
-		// get account -> update it's balance
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	var fee int64
+	if priced {
+		fee, err = TransferFee(ctx, q, TransferFeeParams{
+			Currency:     fromAccount.Currency,
+			TransferType: TransferType(fromAccount.Currency, toAccount.Currency, arg.Scheduled),
+			Amount:       arg.Amount,
+		})
+		if err != nil {
+			return result, err
 		}
+	}
 
+	if fee > 0 {
+		feePostings, err := feePostings(ctx, q, fromAccount, fee)
 		if err != nil {
-			return err
+			return result, err
 		}
+		postings = append(postings, feePostings...)
+	}
 
-		return nil
+	journal, err := postJournal(ctx, q, kind, postings)
+	if err != nil {
+		return result, err
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Sender:        arg.Sender,
+		Recipient:     arg.Recipient,
+		ToAmount:      arg.ToAmount,
+		ExchangeRate:  exchangeRate,
+		JournalID:     journal.Journal.ID,
+		Fee:           fee,
 	})
+	if err != nil {
+		return result, err
+	}
 
-	return result, err
+	result.FromEntry = journal.Entries[0]
+	result.ToEntry = journal.Entries[1]
+	if fee > 0 {
+		result.FeeEntry = journal.Entries[len(journal.Entries)-2]
+	}
+	result.FromAccount = journal.Accounts[fromAccount.ID]
+	result.ToAccount = journal.Accounts[toAccount.ID]
+
+	// the balance update holds the row lock, so this check cannot race with other transfers or holds
+	if result.FromAccount.AvailableBalance < -result.FromAccount.OverdraftLimit {
+		return result, ErrInsufficientFunds
+	}
+
+	return result, nil
 }
 
-func addMoney(
-	ctx context.Context,
-	q *Queries,
-	accountID1 int64,
-	amount1 float64,
-	accountID2 int64,
-	amount2 float64,
-) (account1 Account, account2 Account, err error) {
-	account1, err = q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
-		ID:     accountID1,
-		Amount: amount1,
+// fxPostings returns the postings of the FX accounts that convert fromAmount in fromCurrency into toAmount in toCurrency
+func fxPostings(ctx context.Context, q *Queries, fromCurrency string, fromAmount int64, toCurrency string, toAmount int64) ([]Posting, error) {
+	fromFXAccount, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+		Kind:     AccountKindFX,
+		Currency: fromCurrency,
 	})
-
 	if err != nil {
-		return account1, account2, err
+		return nil, err
 	}
 
-	account2, err = q.UpdateAccountBalance(ctx, UpdateAccountBalanceParams{
-		ID:     accountID2,
-		Amount: amount2,
+	toFXAccount, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+		Kind:     AccountKindFX,
+		Currency: toCurrency,
 	})
+	if err != nil {
+		return nil, err
+	}
 
+	return []Posting{
+		{AccountID: fromFXAccount.ID, Amount: fromAmount},
+		{AccountID: toFXAccount.ID, Amount: -toAmount},
+	}, nil
+}
+
+// feePostings returns the postings that move the fee from the source account to the fees account of its currency
+func feePostings(ctx context.Context, q *Queries, fromAccount Account, fee int64) ([]Posting, error) {
+	feesAccount, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+		Kind:     AccountKindFees,
+		Currency: fromAccount.Currency,
+	})
 	if err != nil {
-		return account1, account2, err
+		return nil, err
 	}
 
-	return
+	return []Posting{
+		{AccountID: fromAccount.ID, Amount: -fee, Description: "transfer fee"},
+		{AccountID: feesAccount.ID, Amount: fee, Description: "transfer fee"},
+	}, nil
 }
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+)
+
+// UpdateUserRoleTx changes the role of a user, blocks all their sessions and revokes their access tokens
+// within a single database transaction, so that no token carrying the old role stays usable
+func (store *SQLStore) UpdateUserRoleTx(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		user, err = q.UpdateUserRole(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		err = q.BlockUserSessions(ctx, user.Username)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.RevokeUserAccessTokens(ctx, user.Username)
+		return err
+	})
+
+	return user, err
+}
@@ -30,6 +30,7 @@ func CreateRandomUser(t *testing.T) User {
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
 
+	require.Equal(t, util.DepositorRole, user.Role)
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
 
@@ -138,3 +139,49 @@ func TestUpdateUserAllFields(t *testing.T) {
 	require.Equal(t, newFullName, updatedUser.FullName)
 	require.Equal(t, newEmail, updatedUser.Email)
 }
+
+func TestUpdateUserRoleTx(t *testing.T) {
+	user := CreateRandomUser(t)
+
+	updatedUser, err := testStore.UpdateUserRoleTx(context.Background(), UpdateUserRoleParams{
+		Username: user.Username,
+		Role:     util.BankerRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Username, updatedUser.Username)
+	require.Equal(t, util.BankerRole, updatedUser.Role)
+
+	_, err = testStore.UpdateUserRoleTx(context.Background(), UpdateUserRoleParams{
+		Username: util.RandomOwner(),
+		Role:     util.BankerRole,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestFreezeUserTx(t *testing.T) {
+	user := CreateRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	result, err := testStore.FreezeUserTx(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.True(t, result.User.IsFrozen)
+	require.Len(t, result.RevokedTokens, 1)
+	require.Equal(t, session.AccessTokenID, result.RevokedTokens[0].ID)
+
+	blocked, err := testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	unfrozenUser, err := testStore.UpdateUserFrozen(context.Background(), UpdateUserFrozenParams{
+		Username: user.Username,
+		IsFrozen: false,
+	})
+	require.NoError(t, err)
+	require.False(t, unfrozenUser.IsFrozen)
+
+	_, err = testStore.FreezeUserTx(context.Background(), SystemUsername)
+	require.ErrorIs(t, err, ErrSystemUser)
+
+	_, err = testStore.FreezeUserTx(context.Background(), util.RandomOwner())
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
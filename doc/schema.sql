@@ -8,6 +8,8 @@ CREATE TABLE "users" (
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
+  "role" varchar NOT NULL DEFAULT 'depositor',
+  "is_frozen" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
@@ -25,16 +27,30 @@ CREATE TABLE "verify_emails" (
 CREATE TABLE "accounts" (
   "id" bigserial PRIMARY KEY,
   "owner" varchar NOT NULL,
-  "balance" float NOT NULL,
+  "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT 'now()'
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT 'now()',
+  "kind" varchar NOT NULL DEFAULT 'customer',
+  "held_balance" bigint NOT NULL DEFAULT 0,
+  "available_balance" bigint NOT NULL GENERATED ALWAYS AS ("balance" - "held_balance") STORED,
+  "max_transfer_amount" bigint NOT NULL DEFAULT 0,
+  "daily_transfer_limit" bigint NOT NULL DEFAULT 0,
+  "monthly_transfer_limit" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  CONSTRAINT "held_balance_not_negative" CHECK ("held_balance" >= 0),
+  CONSTRAINT "transfer_limits_not_negative" CHECK ("max_transfer_amount" >= 0 AND "daily_transfer_limit" >= 0 AND "monthly_transfer_limit" >= 0),
+  CONSTRAINT "status_supported" CHECK ("status" IN ('active', 'frozen', 'closed'))
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
-  "amount" float NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT 'now()'
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT 'now()',
+  "reference" varchar NOT NULL DEFAULT '',
+  "description" varchar NOT NULL DEFAULT '',
+  "journal_id" bigint NOT NULL
 );
 
 CREATE TABLE "transfers" (
@@ -43,16 +59,177 @@ CREATE TABLE "transfers" (
   "to_account_id" bigint NOT NULL,
   "sender" varchar NOT NULL,
   "recipient" varchar NOT NULL,
-  "amount" float NOT NULL,
+  "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" numeric NOT NULL DEFAULT 1,
+  "created_at" timestamptz NOT NULL DEFAULT 'now()',
+  "journal_id" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'original',
+  "refunded_amount" bigint NOT NULL DEFAULT 0,
+  "fee" bigint NOT NULL DEFAULT 0,
+  CONSTRAINT "status_supported" CHECK ("status" IN ('original', 'reversed', 'partially_refunded')),
+  CONSTRAINT "refunded_amount_within_to_amount" CHECK ("refunded_amount" BETWEEN 0 AND "to_amount")
+);
+
+CREATE TABLE "idempotency_keys" (
+  "owner" varchar NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "response" jsonb,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("owner", "idempotency_key")
+);
+
+CREATE TABLE "password_resets" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "secret_code" varchar NOT NULL,
+  "is_used" bool NOT NULL DEFAULT false,
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes'),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "totp_credentials" (
+  "username" varchar PRIMARY KEY,
+  "secret" varchar NOT NULL,
+  "is_enabled" bool NOT NULL DEFAULT false,
+  "last_used_counter" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "recovery_codes" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "hashed_code" varchar NOT NULL,
+  "is_used" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "login_attempts" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "is_successful" bool NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "login_lockouts" (
+  "id" bigserial PRIMARY KEY,
+  "subject_type" varchar NOT NULL,
+  "subject" varchar NOT NULL,
+  "failed_attempts" bigint NOT NULL,
+  "locked_until" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "revoked_tokens" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "api_keys" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "name" varchar NOT NULL,
+  "hashed_secret" varchar NOT NULL,
+  "scopes" varchar[] NOT NULL,
+  "is_revoked" boolean NOT NULL DEFAULT false,
+  "expires_at" timestamptz NOT NULL,
+  "last_used_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "frequency" varchar NOT NULL,
+  "day_of_month" int NOT NULL DEFAULT 0,
+  "next_run_at" timestamptz NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "failed_attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "last_transfer_id" bigint NOT NULL DEFAULT 0,
+  "last_run_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "claimed_until" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "amount_positive" CHECK ("amount" > 0),
+  CONSTRAINT "frequency_supported" CHECK ("frequency" IN ('once', 'weekly', 'monthly')),
+  CONSTRAINT "status_supported" CHECK ("status" IN ('active', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE TABLE "transfer_reversals" (
+  "transfer_id" bigint PRIMARY KEY,
+  "original_transfer_id" bigint NOT NULL,
+  "reason" varchar NOT NULL DEFAULT '',
+  "reversed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "sender" varchar NOT NULL,
+  "recipient" varchar NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'authorized',
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "closed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "amount_positive" CHECK ("amount" > 0),
+  CONSTRAINT "status_supported" CHECK ("status" IN ('authorized', 'captured', 'voided', 'expired')),
+  CONSTRAINT "captured_amount_within_amount" CHECK ("captured_amount" BETWEEN 0 AND "amount")
+);
+
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar NOT NULL,
+  "transfer_type" varchar NOT NULL,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "basis_points" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "transfer_type_supported" CHECK ("transfer_type" IN ('internal', 'cross_currency', 'scheduled')),
+  CONSTRAINT "min_amount_not_negative" CHECK ("min_amount" >= 0),
+  CONSTRAINT "flat_fee_not_negative" CHECK ("flat_fee" >= 0),
+  CONSTRAINT "basis_points_within_100_percent" CHECK ("basis_points" BETWEEN 0 AND 10000),
+  CONSTRAINT "max_fee_not_negative" CHECK ("max_fee" >= 0)
+);
+
+CREATE TABLE "account_status_changes" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "reason" varchar NOT NULL DEFAULT '',
+  "changed_by" varchar NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT 'now()'
 );
 
 CREATE INDEX ON "accounts" ("owner");
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE UNIQUE INDEX ON "accounts" ("owner", "currency", "kind");
 
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE UNIQUE INDEX ON "entries" ("account_id", "reference") WHERE "reference" <> '';
+
+CREATE INDEX ON "entries" ("journal_id");
+
 CREATE INDEX ON "transfers" ("sender", "from_account_id");
 
 CREATE INDEX ON "transfers" ("sender", "to_account_id");
@@ -61,9 +238,121 @@ CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
-COMMENT ON COLUMN "entries"."amount" IS 'can be positive or negative';
+CREATE UNIQUE INDEX ON "transfers" ("journal_id");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+CREATE UNIQUE INDEX ON "recovery_codes" ("username", "hashed_code");
+
+CREATE INDEX ON "login_attempts" ("username", "created_at");
 
-COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
+CREATE INDEX ON "login_attempts" ("client_ip", "created_at");
+
+CREATE INDEX ON "login_lockouts" ("subject_type", "subject", "created_at");
+
+CREATE INDEX ON "revoked_tokens" ("expires_at");
+
+CREATE INDEX ON "api_keys" ("username");
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("next_run_at") WHERE "status" = 'active';
+
+CREATE INDEX ON "transfer_reversals" ("original_transfer_id");
+
+CREATE INDEX ON "holds" ("account_id");
+
+CREATE INDEX ON "holds" ("expires_at") WHERE "status" = 'authorized';
+
+CREATE UNIQUE INDEX ON "fee_rules" ("currency", "transfer_type", "min_amount");
+
+CREATE INDEX ON "account_status_changes" ("account_id");
+
+COMMENT ON COLUMN "users"."role" IS 'depositor, banker or admin';
+
+COMMENT ON COLUMN "accounts"."balance" IS 'in minor units of the account currency';
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the balance may go, in minor units';
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the cash, fees or fx system account of the currency';
+
+COMMENT ON COLUMN "accounts"."held_balance" IS 'reserved by authorized holds, in minor units of the account currency';
+
+COMMENT ON COLUMN "accounts"."available_balance" IS 'balance less held_balance, what transfers and withdrawals may spend';
+
+COMMENT ON COLUMN "accounts"."max_transfer_amount" IS 'overrides the default limit of the currency, 0 to keep it';
+
+COMMENT ON COLUMN "accounts"."daily_transfer_limit" IS 'overrides the default limit of the currency, 0 to keep it';
+
+COMMENT ON COLUMN "accounts"."monthly_transfer_limit" IS 'overrides the default limit of the currency, 0 to keep it';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed; only active accounts take part in journals and holds';
+
+COMMENT ON COLUMN "entries"."amount" IS 'can be positive or negative, in minor units';
+
+COMMENT ON COLUMN "entries"."reference" IS 'external reference of a deposit or withdrawal, posted at most once per account';
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive, debited in minor units of the source account currency';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'credited in minor units of the destination account currency';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'units of the destination currency bought by one unit of the source currency';
+
+COMMENT ON COLUMN "transfers"."status" IS 'original, reversed or partially_refunded';
+
+COMMENT ON COLUMN "transfers"."refunded_amount" IS 'sent back by reversals, in minor units of the destination account currency';
+
+COMMENT ON COLUMN "transfers"."fee" IS 'charged to the source account on top of the amount, in minor units of its currency';
+
+COMMENT ON COLUMN "idempotency_keys"."response" IS 'stored result returned on replay';
+
+COMMENT ON COLUMN "totp_credentials"."last_used_counter" IS 'time step of the last accepted code';
+
+COMMENT ON COLUMN "login_attempts"."username" IS 'as sent by the client, the user may not exist';
+
+COMMENT ON COLUMN "login_lockouts"."subject_type" IS 'username or client_ip';
+
+COMMENT ON COLUMN "revoked_tokens"."id" IS 'id of the revoked access token';
+
+COMMENT ON COLUMN "api_keys"."hashed_secret" IS 'sha256 of the secret part of the key, which is only shown once';
+
+COMMENT ON COLUMN "api_keys"."last_used_at" IS 'updated at most once a minute';
+
+COMMENT ON COLUMN "journals"."kind" IS 'transfer, reversal, deposit, withdrawal or legacy';
+
+COMMENT ON COLUMN "scheduled_transfers"."amount" IS 'in minor units of the currency both accounts share';
+
+COMMENT ON COLUMN "scheduled_transfers"."day_of_month" IS 'day a monthly transfer runs on, the last day of shorter months';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'when the next run is due';
+
+COMMENT ON COLUMN "scheduled_transfers"."failed_attempts" IS 'failed attempts of the next run';
+
+COMMENT ON COLUMN "scheduled_transfers"."claimed_until" IS 'skipped until then, while a worker runs it or before a retry';
+
+COMMENT ON COLUMN "transfer_reversals"."transfer_id" IS 'the compensating transfer, from the recipient back to the sender';
+
+COMMENT ON COLUMN "holds"."account_id" IS 'the account the money is held on';
+
+COMMENT ON COLUMN "holds"."to_account_id" IS 'the account a capture pays into';
+
+COMMENT ON COLUMN "holds"."amount" IS 'in minor units of the currency both accounts share';
+
+COMMENT ON COLUMN "holds"."transfer_id" IS 'the transfer of the capture, 0 until captured';
+
+COMMENT ON TABLE "fee_rules" IS 'a transfer is priced by the rule of its currency and type with the highest min_amount not above its amount';
+
+COMMENT ON COLUMN "fee_rules"."currency" IS 'of the source account, which pays the fee';
+
+COMMENT ON COLUMN "fee_rules"."transfer_type" IS 'internal, cross_currency or scheduled';
+
+COMMENT ON COLUMN "fee_rules"."min_amount" IS 'lower bound of the tier, in minor units';
+
+COMMENT ON COLUMN "fee_rules"."basis_points" IS 'percentage of the amount, in hundredths of a percent';
+
+COMMENT ON COLUMN "fee_rules"."max_fee" IS 'cap of the fee, 0 for none';
+
+COMMENT ON COLUMN "account_status_changes"."changed_by" IS 'the owner closing the account, or the banker freezing, unfreezing or reopening it';
 
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
@@ -71,6 +360,8 @@ ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username")
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
 ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
@@ -78,3 +369,41 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts"
 ALTER TABLE "transfers" ADD FOREIGN KEY ("sender") REFERENCES "users" ("username");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("recipient") REFERENCES "users" ("username");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "password_resets" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "totp_credentials" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "recovery_codes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "revoked_tokens" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "api_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("original_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_reversals" ADD FOREIGN KEY ("reversed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("sender") REFERENCES "users" ("username");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("recipient") REFERENCES "users" ("username");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_changes" ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
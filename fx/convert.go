@@ -0,0 +1,86 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/uwemakan/simplebank/util"
+)
+
+// Conversion is the result of converting an amount between two currencies
+type Conversion struct {
+	FromCurrency string
+	ToCurrency   string
+	FromAmount   int64
+	ToAmount     int64
+	Rate         *big.Rat
+}
+
+// RateString returns the exchange rate as a decimal string
+func (conversion Conversion) RateString() string {
+	return conversion.Rate.FloatString(RatePrecision)
+}
+
+// ErrAmountTooSmall is returned when an amount converts to less than one minor unit of the to currency
+var ErrAmountTooSmall = errors.New("amount is too small to convert")
+
+// Convert converts an amount in minor units of the from currency into minor units of the to currency.
+// The result is rounded half away from zero to the nearest minor unit.
+// It returns ErrAmountTooSmall if a positive amount rounds to zero.
+func Convert(ctx context.Context, provider RateProvider, amount int64, from, to string) (Conversion, error) {
+	fromExponent, err := util.CurrencyExponent(from)
+	if err != nil {
+		return Conversion{}, err
+	}
+
+	toExponent, err := util.CurrencyExponent(to)
+	if err != nil {
+		return Conversion{}, err
+	}
+
+	rate, err := provider.GetRate(ctx, from, to)
+	if err != nil {
+		return Conversion{}, err
+	}
+
+	// amount / 10^fromExponent * rate * 10^toExponent
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(toExponent), pow10(fromExponent)))
+
+	toAmount := roundHalfAwayFromZero(value)
+	if !toAmount.IsInt64() {
+		return Conversion{}, fmt.Errorf("converted amount overflows")
+	}
+	if amount > 0 && toAmount.Sign() <= 0 {
+		return Conversion{}, fmt.Errorf("%w: %d %s is less than one minor unit of %s", ErrAmountTooSmall, amount, from, to)
+	}
+
+	return Conversion{
+		FromCurrency: from,
+		ToCurrency:   to,
+		FromAmount:   amount,
+		ToAmount:     toAmount.Int64(),
+		Rate:         rate,
+	}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func roundHalfAwayFromZero(value *big.Rat) *big.Int {
+	num := new(big.Int).Abs(value.Num())
+	den := value.Denom()
+
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(den) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	if value.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+	return quotient
+}
@@ -0,0 +1,80 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileRateProvider serves exchange rates from a JSON file such as {"USD/EUR": "0.92"}.
+// The file is read again whenever its modification time changes.
+type FileRateProvider struct {
+	path    string
+	mu      sync.RWMutex
+	modTime time.Time
+	static  *StaticRateProvider
+}
+
+// NewFileRateProvider creates a new FileRateProvider and loads the rates file
+func NewFileRateProvider(path string) (*FileRateProvider, error) {
+	provider := &FileRateProvider{
+		path: path,
+	}
+
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// GetRate returns the exchange rate between two currencies from the latest rates file
+func (provider *FileRateProvider) GetRate(ctx context.Context, from, to string) (*big.Rat, error) {
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+
+	provider.mu.RLock()
+	defer provider.mu.RUnlock()
+	return provider.static.GetRate(ctx, from, to)
+}
+
+// reload reads the rates file if it has changed since it was last loaded
+func (provider *FileRateProvider) reload() error {
+	info, err := os.Stat(provider.path)
+	if err != nil {
+		return fmt.Errorf("cannot stat rates file: %w", err)
+	}
+
+	provider.mu.RLock()
+	upToDate := provider.static != nil && info.ModTime().Equal(provider.modTime)
+	provider.mu.RUnlock()
+	if upToDate {
+		return nil
+	}
+
+	data, err := os.ReadFile(provider.path)
+	if err != nil {
+		return fmt.Errorf("cannot read rates file: %w", err)
+	}
+
+	var rates map[string]string
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return fmt.Errorf("cannot parse rates file: %w", err)
+	}
+
+	static, err := NewStaticRateProvider(rates)
+	if err != nil {
+		return err
+	}
+
+	provider.mu.Lock()
+	provider.static = static
+	provider.modTime = info.ModTime()
+	provider.mu.Unlock()
+	return nil
+}
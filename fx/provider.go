@@ -0,0 +1,93 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RatePrecision is the number of decimal places exchange rates are rounded to
+const RatePrecision = 10
+
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// RateProvider is an interface for looking up exchange rates
+type RateProvider interface {
+	// GetRate returns how many units of the to currency one unit of the from currency buys
+	GetRate(ctx context.Context, from, to string) (*big.Rat, error)
+}
+
+// StaticRateProvider serves exchange rates from a fixed table
+type StaticRateProvider struct {
+	rates map[string]*big.Rat
+}
+
+// NewStaticRateProvider creates a new StaticRateProvider.
+// The rates are keyed by currency pair, e.g. "USD/EUR", and given as decimal strings, e.g. "0.92".
+func NewStaticRateProvider(rates map[string]string) (*StaticRateProvider, error) {
+	provider := &StaticRateProvider{
+		rates: make(map[string]*big.Rat, len(rates)),
+	}
+
+	for pair, value := range rates {
+		currencies := strings.Split(pair, "/")
+		if len(currencies) != 2 || currencies[0] == "" || currencies[1] == "" {
+			return nil, fmt.Errorf("invalid currency pair %q: must be in the form FROM/TO", pair)
+		}
+
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate %q for %s: must be a positive decimal", value, pair)
+		}
+
+		// direct rates are rounded like the inverted ones, so every rate served is the one recorded on a transfer
+		rate = roundRate(rate)
+		if rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate %q for %s: rounds to zero at %d decimal places", value, pair, RatePrecision)
+		}
+
+		provider.rates[pairKey(currencies[0], currencies[1])] = rate
+	}
+
+	return provider, nil
+}
+
+// GetRate returns the exchange rate between two currencies.
+// If only the opposite direction is in the table, its inverse is used.
+func (provider *StaticRateProvider) GetRate(ctx context.Context, from, to string) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	if rate, ok := provider.rates[pairKey(from, to)]; ok {
+		return new(big.Rat).Set(rate), nil
+	}
+
+	if rate, ok := provider.rates[pairKey(to, from)]; ok {
+		return roundRate(new(big.Rat).Inv(rate)), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+}
+
+// NewRateProvider creates a FileRateProvider if a rates file is given.
+// Otherwise it returns an empty StaticRateProvider, which only supports same-currency conversions.
+func NewRateProvider(ratesFile string) (RateProvider, error) {
+	if ratesFile == "" {
+		return NewStaticRateProvider(nil)
+	}
+	return NewFileRateProvider(ratesFile)
+}
+
+func pairKey(from, to string) string {
+	return from + "/" + to
+}
+
+// roundRate rounds the rate to RatePrecision decimal places,
+// so that the rate recorded on a transfer is exactly the one used for the conversion
+func roundRate(rate *big.Rat) *big.Rat {
+	rounded, _ := new(big.Rat).SetString(rate.FloatString(RatePrecision))
+	return rounded
+}
@@ -0,0 +1,125 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestStaticRateProvider(t *testing.T) {
+	provider, err := NewStaticRateProvider(map[string]string{
+		"USD/EUR": "0.92",
+	})
+	require.NoError(t, err)
+
+	rate, err := provider.GetRate(context.Background(), util.USD, util.EUR)
+	require.NoError(t, err)
+	require.Equal(t, "0.92", rate.FloatString(2))
+
+	rate, err = provider.GetRate(context.Background(), util.EUR, util.USD)
+	require.NoError(t, err)
+	require.Equal(t, "1.0869565217", rate.FloatString(RatePrecision))
+
+	rate, err = provider.GetRate(context.Background(), util.GBP, util.GBP)
+	require.NoError(t, err)
+	require.Equal(t, "1", rate.RatString())
+
+	_, err = provider.GetRate(context.Background(), util.USD, util.NGN)
+	require.True(t, errors.Is(err, ErrRateNotFound))
+
+	// a direct rate is rounded to the precision it is recorded with
+	provider, err = NewStaticRateProvider(map[string]string{
+		"USD/NGN": "1500.123456789012",
+	})
+	require.NoError(t, err)
+
+	rate, err = provider.GetRate(context.Background(), util.USD, util.NGN)
+	require.NoError(t, err)
+	require.Equal(t, "1500.1234567890", rate.FloatString(RatePrecision))
+	require.Equal(t, "1500.123456789000", rate.FloatString(RatePrecision+2))
+}
+
+func TestStaticRateProviderInvalidRates(t *testing.T) {
+	_, err := NewStaticRateProvider(map[string]string{"USDEUR": "0.92"})
+	require.Error(t, err)
+
+	_, err = NewStaticRateProvider(map[string]string{"USD/EUR": "abc"})
+	require.Error(t, err)
+
+	_, err = NewStaticRateProvider(map[string]string{"USD/EUR": "-1"})
+	require.Error(t, err)
+
+	_, err = NewStaticRateProvider(map[string]string{"USD/EUR": "0.00000000001"})
+	require.Error(t, err)
+}
+
+func TestFileRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"USD/EUR": "0.92"}`), 0o600))
+
+	provider, err := NewFileRateProvider(path)
+	require.NoError(t, err)
+
+	rate, err := provider.GetRate(context.Background(), util.USD, util.EUR)
+	require.NoError(t, err)
+	require.Equal(t, "0.92", rate.FloatString(2))
+
+	// the file is reloaded once it changes
+	require.NoError(t, os.WriteFile(path, []byte(`{"USD/EUR": "0.95"}`), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	rate, err = provider.GetRate(context.Background(), util.USD, util.EUR)
+	require.NoError(t, err)
+	require.Equal(t, "0.95", rate.FloatString(2))
+
+	_, err = NewFileRateProvider(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestConvert(t *testing.T) {
+	provider, err := NewStaticRateProvider(map[string]string{
+		"USD/EUR": "0.92",
+		"USD/NGN": "775.5",
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		amount   int64
+		from     string
+		to       string
+		expected int64
+	}{
+		{"SameCurrency", 12345, util.USD, util.USD, 12345},
+		{"Direct", 10000, util.USD, util.EUR, 9200},
+		{"RoundHalfUp", 1, util.USD, util.NGN, 776},
+		{"RoundDown", 3, util.USD, util.EUR, 3},
+		{"Inverse", 9200, util.EUR, util.USD, 10000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conversion, err := Convert(context.Background(), provider, tc.amount, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.amount, conversion.FromAmount)
+			require.Equal(t, tc.expected, conversion.ToAmount)
+			require.NotEmpty(t, conversion.RateString())
+		})
+	}
+
+	_, err = Convert(context.Background(), provider, 100, util.EUR, util.NGN)
+	require.ErrorIs(t, err, ErrRateNotFound)
+
+	// one kobo is worth less than half a cent
+	_, err = Convert(context.Background(), provider, 1, util.NGN, util.USD)
+	require.ErrorIs(t, err, ErrAmountTooSmall)
+
+	_, err = Convert(context.Background(), provider, 100, "ABC", util.USD)
+	require.Error(t, err)
+}
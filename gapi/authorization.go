@@ -5,42 +5,146 @@ import (
 	"fmt"
 	"strings"
 
+	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	authorizationHeader = "authorization"
 	authorizationBearer = "bearer"
+	authorizationAPIKey = "apikey"
 )
 
-func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+// Roles allowed to call an RPC
+var (
+	allRoles    = []string{util.DepositorRole, util.BankerRole, util.AdminRole}
+	bankerRoles = []string{util.BankerRole, util.AdminRole}
+	adminRoles  = []string{util.AdminRole}
+)
+
+// authorizeUser authenticates the caller with an access token and checks that their role is one of accessibleRoles.
+// The returned error is a gRPC status, Unauthenticated or PermissionDenied.
+func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
+	return server.authorizeClient(ctx, accessibleRoles, "")
+}
+
+// authorizeClient is authorizeUser for the RPCs that server-to-server clients may call,
+// which also accepts an API key of the caller that was granted scope
+func (server *Server) authorizeClient(ctx context.Context, accessibleRoles []string, scope string) (*token.Payload, error) {
+	authType, credential, err := authorizationCredential(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var payload *token.Payload
+	switch authType {
+	case authorizationBearer:
+		payload, err = server.authenticateUser(ctx, credential)
+	case authorizationAPIKey:
+		if scope == "" {
+			return nil, status.Errorf(codes.PermissionDenied, "API keys are not accepted by this method")
+		}
+		payload, err = server.authenticateAPIKey(ctx, credential, scope)
+	default:
+		err = fmt.Errorf("unsupported authorization type: %s", authType)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, unauthenticatedError(err)
+	}
+
+	if !hasRole(payload.Role, accessibleRoles) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied for role %q", payload.Role)
+	}
+
+	return payload, nil
+}
+
+// authorizationCredential returns the lowercased type and the credential of the authorization header
+func authorizationCredential(ctx context.Context) (string, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, fmt.Errorf("missing metadata")
+		return "", "", fmt.Errorf("missing metadata")
 	}
 
 	values := md.Get(authorizationHeader)
 	if len(values) == 0 {
-		return nil, fmt.Errorf("missing authorization header")
+		return "", "", fmt.Errorf("missing authorization header")
 	}
 
 	authHeader := values[0]
 	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
-		return nil, fmt.Errorf("invalid authorization header format")
+		return "", "", fmt.Errorf("invalid authorization header format")
 	}
 
-	authType := strings.ToLower(fields[0])
-	if authType != authorizationBearer {
-		return nil, fmt.Errorf("unsupported authorization type: %s", authType)
-	}
+	return strings.ToLower(fields[0]), fields[1], nil
+}
 
-	accessToken := fields[1]
-	payload, err := server.tokenMaker.VerifyToken(accessToken)
+func (server *Server) authenticateUser(ctx context.Context, accessToken string) (*token.Payload, error) {
+	payload, err := server.tokenMaker.VerifyToken(accessToken, token.TokenTypeAccessToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid access token: %s", err)
 	}
 
+	if err := server.passwordChecker.Check(ctx, payload); err != nil {
+		return nil, fmt.Errorf("invalid access token: %s", err)
+	}
+
+	if err := server.revocationChecker.Check(ctx, payload); err != nil {
+		return nil, fmt.Errorf("invalid access token: %s", err)
+	}
+
 	return payload, nil
 }
+
+// authenticateAPIKey verifies the API key, checks that it was granted scope and records its use
+func (server *Server) authenticateAPIKey(ctx context.Context, apiKey string, scope string) (*token.Payload, error) {
+	id, secret, err := token.ParseAPIKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := server.store.GetApiKeyWithRole(ctx, id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, token.ErrInvalidAPIKey
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get API key: %s", err)
+	}
+
+	key := row.ApiKey
+	if err := token.VerifyAPIKey(secret, key.HashedSecret, key.IsRevoked, key.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	// the keys of a frozen user work again once the user is unfrozen, so they are refused rather than revoked
+	if row.IsFrozen {
+		return nil, fmt.Errorf("the owner of the API key is frozen")
+	}
+
+	if !util.HasScope(key.Scopes, scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key is not granted scope %q", scope)
+	}
+
+	if err := server.store.UpdateApiKeyLastUsed(ctx, key.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update API key: %s", err)
+	}
+
+	return token.NewAPIKeyPayload(key.ID, key.Username, row.Role, key.CreatedAt, key.ExpiresAt), nil
+}
+
+func hasRole(role string, roles []string) bool {
+	for _, r := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizeUserPasswordChanged(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	config := util.Config{
+		TokenSigningKey:     tokenSigningKey,
+		AccessTokenDuration: accessTokenDuration,
+	}
+	server, err := NewServer(config, store, nil)
+	require.NoError(t, err)
+
+	oldCtx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.DepositorRole, time.Minute)
+	user.PasswordChangedAt = time.Now()
+	time.Sleep(time.Millisecond)
+	newCtx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.DepositorRole, time.Minute)
+
+	// the user is loaded once, then served from the cache
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+
+	_, err = server.authorizeUser(oldCtx, allRoles)
+	require.Error(t, err)
+
+	payload, err := server.authorizeUser(newCtx, allRoles)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, payload.Username)
+}
+
+func TestAuthorizeUserRole(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	server := newTestServer(t, nil, nil)
+
+	testCases := []struct {
+		name            string
+		role            string
+		accessibleRoles []string
+		code            codes.Code
+	}{
+		{
+			name:            "Allowed",
+			role:            util.BankerRole,
+			accessibleRoles: allRoles,
+			code:            codes.OK,
+		},
+		{
+			name:            "AdminOnly",
+			role:            util.AdminRole,
+			accessibleRoles: adminRoles,
+			code:            codes.OK,
+		},
+		{
+			name:            "PermissionDenied",
+			role:            util.BankerRole,
+			accessibleRoles: adminRoles,
+			code:            codes.PermissionDenied,
+		},
+		{
+			name:            "UnknownRole",
+			role:            "",
+			accessibleRoles: allRoles,
+			code:            codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, tc.role, time.Minute)
+
+			payload, err := server.authorizeUser(ctx, tc.accessibleRoles)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, tc.role, payload.Role)
+			}
+		})
+	}
+}
+
+func TestAuthorizeUserRevokedToken(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, store, nil)
+	server.revocationChecker = token.NewRevocationChecker(server.isTokenRevoked, time.Minute)
+
+	// a token revoked through another server is looked up
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.DepositorRole, time.Minute)
+	store.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+
+	_, err := server.authorizeUser(ctx, allRoles)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// a token revoked through this server is rejected without a lookup
+	accessToken, payload, err := server.tokenMaker.CreateToken(user.Username, util.DepositorRole, time.Minute, token.TokenTypeAccessToken)
+	require.NoError(t, err)
+	server.cacheRevokedTokens([]db.RevokedToken{{ID: payload.ID, Username: user.Username, ExpiresAt: payload.ExpiredAt}})
+
+	md := metadata.MD{
+		authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationBearer, accessToken)},
+	}
+	_, err = server.authorizeUser(metadata.NewIncomingContext(context.Background(), md), allRoles)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorizeClientAPIKey(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	testCases := []struct {
+		name       string
+		scope      string
+		buildKey   func(t *testing.T) (db.ApiKey, string)
+		buildStubs func(store *mockdb.MockStore, apiKey db.ApiKey)
+		code       codes.Code
+	}{
+		{
+			name:  "OK",
+			scope: util.AccountsReadScope,
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().
+					UpdateApiKeyLastUsed(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:  "MissingScope",
+			scope: util.TransfersWriteScope,
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().UpdateApiKeyLastUsed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:  "AccessTokensOnly",
+			scope: "",
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().GetApiKeyWithRole(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:  "ExpiredKey",
+			scope: util.AccountsReadScope,
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				apiKey, key := randomAPIKey(t, user.Username, util.AccountsReadScope)
+				apiKey.ExpiresAt = time.Now().Add(-time.Minute)
+				return apiKey, key
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().UpdateApiKeyLastUsed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:  "FrozenOwner",
+			scope: util.AccountsReadScope,
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				return randomAPIKey(t, user.Username, util.AccountsReadScope)
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role, IsFrozen: true}, nil)
+				store.EXPECT().UpdateApiKeyLastUsed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:  "WrongSecret",
+			scope: util.AccountsReadScope,
+			buildKey: func(t *testing.T) (db.ApiKey, string) {
+				apiKey, _ := randomAPIKey(t, user.Username, util.AccountsReadScope)
+				key, _, err := token.NewAPIKey(apiKey.ID)
+				require.NoError(t, err)
+				return apiKey, key
+			},
+			buildStubs: func(store *mockdb.MockStore, apiKey db.ApiKey) {
+				store.EXPECT().
+					GetApiKeyWithRole(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.GetApiKeyWithRoleRow{ApiKey: apiKey, Role: user.Role}, nil)
+				store.EXPECT().UpdateApiKeyLastUsed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiKey, key := tc.buildKey(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, apiKey)
+
+			server := newTestServer(t, store, nil)
+			md := metadata.MD{
+				authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationAPIKey, key)},
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			payload, err := server.authorizeClient(ctx, allRoles, tc.scope)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, user.Username, payload.Username)
+				require.Equal(t, user.Role, payload.Role)
+				require.Equal(t, token.TokenTypeAPIKey, payload.Type)
+			}
+		})
+	}
+}
+
+func randomAPIKey(t *testing.T, username string, scopes ...string) (db.ApiKey, string) {
+	id := uuid.New()
+	key, hashedSecret, err := token.NewAPIKey(id)
+	require.NoError(t, err)
+
+	apiKey := db.ApiKey{
+		ID:           id,
+		Username:     username,
+		Name:         util.RandomString(6),
+		HashedSecret: hashedSecret,
+		Scopes:       scopes,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		CreatedAt:    time.Now(),
+	}
+	return apiKey, key
+}
@@ -3,6 +3,7 @@ package gapi
 import (
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/pb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,6 +16,8 @@ func ConvertUser(user db.User) *pb.User {
 		Email:             user.Email,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
+		Role:              user.Role,
+		IsFrozen:          user.IsFrozen,
 	}
 }
 
@@ -32,3 +35,160 @@ func ConvertLoginResponse(
 		User:                  ConvertUser(user),
 	}
 }
+
+func ConvertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:               account.ID,
+		Owner:            account.Owner,
+		Balance:          account.Balance,
+		Currency:         account.Currency,
+		OverdraftLimit:   account.OverdraftLimit,
+		CreatedAt:        timestamppb.New(account.CreatedAt),
+		HeldBalance:      account.HeldBalance,
+		AvailableBalance: account.AvailableBalance,
+		Status:           account.Status,
+	}
+}
+
+func ConvertAccountStatusChange(statusChange db.AccountStatusChange) *pb.AccountStatusChange {
+	return &pb.AccountStatusChange{
+		Id:         statusChange.ID,
+		AccountId:  statusChange.AccountID,
+		FromStatus: statusChange.FromStatus,
+		ToStatus:   statusChange.ToStatus,
+		Reason:     statusChange.Reason,
+		ChangedBy:  statusChange.ChangedBy,
+		CreatedAt:  timestamppb.New(statusChange.CreatedAt),
+	}
+}
+
+func ConvertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:             transfer.ID,
+		FromAccountId:  transfer.FromAccountID,
+		ToAccountId:    transfer.ToAccountID,
+		Sender:         transfer.Sender,
+		Recipient:      transfer.Recipient,
+		Amount:         transfer.Amount,
+		CreatedAt:      timestamppb.New(transfer.CreatedAt),
+		ToAmount:       transfer.ToAmount,
+		ExchangeRate:   convertNumeric(transfer.ExchangeRate),
+		Status:         transfer.Status,
+		RefundedAmount: transfer.RefundedAmount,
+		Fee:            transfer.Fee,
+	}
+}
+
+// convertNumeric formats a numeric column as a decimal string
+func convertNumeric(n pgtype.Numeric) string {
+	value, err := n.Value()
+	if err != nil || value == nil {
+		return ""
+	}
+	return value.(string)
+}
+
+func ConvertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+}
+
+func ConvertApiKey(apiKey db.ApiKey) *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         apiKey.ID.String(),
+		Name:       apiKey.Name,
+		Scopes:     apiKey.Scopes,
+		IsRevoked:  apiKey.IsRevoked,
+		ExpiresAt:  timestamppb.New(apiKey.ExpiresAt),
+		LastUsedAt: timestamppb.New(apiKey.LastUsedAt),
+		CreatedAt:  timestamppb.New(apiKey.CreatedAt),
+	}
+}
+
+func ConvertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:          entry.ID,
+		AccountId:   entry.AccountID,
+		Amount:      entry.Amount,
+		CreatedAt:   timestamppb.New(entry.CreatedAt),
+		Reference:   entry.Reference,
+		Description: entry.Description,
+	}
+}
+
+func ConvertStatement(account db.Account, statement db.StatementTxResult) *pb.GetStatementResponse {
+	rsp := &pb.GetStatementResponse{
+		AccountId:      account.ID,
+		Currency:       account.Currency,
+		FromTime:       timestamppb.New(statement.FromTime),
+		ToTime:         timestamppb.New(statement.ToTime),
+		OpeningBalance: statement.OpeningBalance,
+		Lines:          make([]*pb.StatementLine, len(statement.Lines)),
+		ClosingBalance: statement.ClosingBalance,
+	}
+	for i, line := range statement.Lines {
+		rsp.Lines[i] = &pb.StatementLine{
+			Entry:                 ConvertEntry(line.Entry),
+			JournalKind:           line.JournalKind,
+			TransferId:            line.TransferID,
+			CounterpartyAccountId: line.CounterpartyAccountID,
+			Counterparty:          line.Counterparty,
+			Balance:               line.Balance,
+		}
+	}
+	return rsp
+}
+
+func ConvertScheduledTransfer(scheduledTransfer db.ScheduledTransfer) *pb.ScheduledTransfer {
+	return &pb.ScheduledTransfer{
+		Id:             scheduledTransfer.ID,
+		Owner:          scheduledTransfer.Owner,
+		FromAccountId:  scheduledTransfer.FromAccountID,
+		ToAccountId:    scheduledTransfer.ToAccountID,
+		Amount:         scheduledTransfer.Amount,
+		Frequency:      scheduledTransfer.Frequency,
+		DayOfMonth:     scheduledTransfer.DayOfMonth,
+		NextRunAt:      timestamppb.New(scheduledTransfer.NextRunAt),
+		Status:         scheduledTransfer.Status,
+		FailedAttempts: scheduledTransfer.FailedAttempts,
+		LastError:      scheduledTransfer.LastError,
+		LastTransferId: scheduledTransfer.LastTransferID,
+		LastRunAt:      timestamppb.New(scheduledTransfer.LastRunAt),
+		CreatedAt:      timestamppb.New(scheduledTransfer.CreatedAt),
+	}
+}
+
+func ConvertHold(hold db.Hold) *pb.Hold {
+	return &pb.Hold{
+		Id:             hold.ID,
+		AccountId:      hold.AccountID,
+		ToAccountId:    hold.ToAccountID,
+		Sender:         hold.Sender,
+		Recipient:      hold.Recipient,
+		Amount:         hold.Amount,
+		Status:         hold.Status,
+		CapturedAmount: hold.CapturedAmount,
+		TransferId:     hold.TransferID,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt),
+		ClosedAt:       timestamppb.New(hold.ClosedAt),
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+	}
+}
+
+func ConvertFeeRule(rule db.FeeRule) *pb.FeeRule {
+	return &pb.FeeRule{
+		Id:           rule.ID,
+		Currency:     rule.Currency,
+		TransferType: rule.TransferType,
+		MinAmount:    rule.MinAmount,
+		FlatFee:      rule.FlatFee,
+		BasisPoints:  rule.BasisPoints,
+		MaxFee:       rule.MaxFee,
+		CreatedAt:    timestamppb.New(rule.CreatedAt),
+	}
+}
@@ -1,6 +1,9 @@
 package gapi
 
 import (
+	"strconv"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,3 +30,23 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return  status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+// transferLimitError reports the exceeded transfer limit with the allowance it has left
+func transferLimitError(limitErr *db.TransferLimitError) error {
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: "TRANSFER_LIMIT_EXCEEDED",
+		Domain: "simplebank",
+		Metadata: map[string]string{
+			"limit":     limitErr.Limit,
+			"currency":  limitErr.Currency,
+			"remaining": strconv.FormatInt(limitErr.Remaining, 10),
+		},
+	}
+	statusExhausted := status.New(codes.ResourceExhausted, limitErr.Error())
+
+	statusDetails, err := statusExhausted.WithDetails(errorInfo)
+	if err != nil {
+		return statusExhausted.Err()
+	}
+	return statusDetails.Err()
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// JWKSPath is where the gateway publishes the public keys tokens are signed with
+const JWKSPath = "/.well-known/jwks.json"
+
+// jwksMaxAge lets verifiers cache the key set; a new signing key must be added
+// as a verification key at least this long before it starts signing tokens
+const jwksMaxAge = "max-age=300"
+
+// JWKSHandler serves the public keys of the token keyring, so that other services can verify tokens on their own.
+func (server *Server) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			res.Header().Set("Allow", "GET, HEAD")
+			http.Error(res, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/jwk-set+json")
+		res.Header().Set("Cache-Control", "public, "+jwksMaxAge)
+		if err := json.NewEncoder(res).Encode(server.keyring.JWKS()); err != nil {
+			log.Error().Err(err).Msg("failed to write jwks")
+		}
+	})
+}
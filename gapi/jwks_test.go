@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	signingKey, _, err := token.GenerateSigningKey()
+	require.NoError(t, err)
+	_, oldPublicKey, err := token.GenerateSigningKey()
+	require.NoError(t, err)
+
+	config := util.Config{
+		TokenSigningKey:       signingKey,
+		TokenVerificationKeys: []string{oldPublicKey},
+		AccessTokenDuration:   accessTokenDuration,
+	}
+	server, err := NewServer(config, nil, nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, JWKSPath, nil)
+	server.JWKSHandler().ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/jwk-set+json", recorder.Header().Get("Content-Type"))
+
+	var jwks token.JSONWebKeySet
+	err = json.NewDecoder(recorder.Body).Decode(&jwks)
+	require.NoError(t, err)
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, server.keyring.SigningKeyID(), jwks.Keys[0].KeyID)
+
+	// a downstream service verifies tokens with the published keys alone
+	accessToken, payload, err := server.tokenMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute, token.TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	verifiedPayload := &token.Payload{}
+	_, err = jwt.ParseWithClaims(accessToken, verifiedPayload, func(tk *jwt.Token) (interface{}, error) {
+		for _, key := range jwks.Keys {
+			if key.KeyID == tk.Header["kid"] {
+				x, err := base64.RawURLEncoding.DecodeString(key.X)
+				return ed25519.PublicKey(x), err
+			}
+		}
+		return nil, errors.New("unknown key")
+	})
+	require.NoError(t, err)
+	require.Equal(t, payload.ID, verifiedPayload.ID)
+
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest(http.MethodPost, JWKSPath, nil)
+	server.JWKSHandler().ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,237 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/worker"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subjects that failed logins are counted and locked out for
+const (
+	lockoutSubjectUsername = "username"
+	lockoutSubjectClientIP = "client_ip"
+)
+
+// maxLoginDelay caps the progressive delay of login attempts
+const maxLoginDelay = 10 * time.Second
+
+var errInvalidCredentials = errors.New("invalid username or password")
+
+// dummyPasswordHash is checked against when the user does not exist,
+// so that unknown usernames take as long to reject as wrong passwords
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte(util.RandomString(16)), bcrypt.DefaultCost)
+
+// loginGuard tracks the failed attempts of one login request's username and client IP
+type loginGuard struct {
+	server        *Server
+	username      string
+	clientIP      string
+	usernameSince time.Time
+	clientIPSince time.Time
+	failures      int64
+}
+
+// guardLogin refuses the attempt with ResourceExhausted while the username or the client IP
+// is locked out, and otherwise counts the recent failures of the username
+func (server *Server) guardLogin(ctx context.Context, username string, clientIP string) (*loginGuard, error) {
+	guard := &loginGuard{
+		server:   server,
+		username: username,
+		clientIP: clientIP,
+	}
+
+	var err error
+	guard.usernameSince, err = server.loginFailuresSince(ctx, lockoutSubjectUsername, username)
+	if err != nil {
+		return nil, err
+	}
+
+	guard.clientIPSince, err = server.loginFailuresSince(ctx, lockoutSubjectClientIP, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	guard.failures, err = server.store.CountLoginFailuresByUsername(ctx, db.CountLoginFailuresByUsernameParams{
+		Username: username,
+		Since:    guard.usernameSince,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count login failures: %s", err)
+	}
+
+	return guard, nil
+}
+
+// loginFailuresSince returns the time from which failed logins of the subject count,
+// which is the start of the failure window or the end of the last lockout, whichever is later
+func (server *Server) loginFailuresSince(ctx context.Context, subjectType string, subject string) (time.Time, error) {
+	since := time.Now().Add(-server.config.LoginFailureWindow)
+
+	lockout, err := server.store.GetLatestLoginLockout(ctx, db.GetLatestLoginLockoutParams{
+		SubjectType: subjectType,
+		Subject:     subject,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return since, nil
+		}
+		return since, status.Errorf(codes.Internal, "failed to get login lockout: %s", err)
+	}
+
+	if lockout.LockedUntil.After(time.Now()) {
+		return since, status.Errorf(codes.ResourceExhausted, "too many failed login attempts, try again after %s", lockout.LockedUntil.Format(time.RFC3339))
+	}
+
+	if lockout.LockedUntil.After(since) {
+		since = lockout.LockedUntil
+	}
+	return since, nil
+}
+
+// delay slows the attempt down, doubling the wait with every recent failure of the username
+func (guard *loginGuard) delay(ctx context.Context) error {
+	if guard.failures == 0 || guard.server.config.LoginDelayBase <= 0 {
+		return nil
+	}
+
+	delay := maxLoginDelay
+	if guard.failures < 16 {
+		delay = guard.server.config.LoginDelayBase << (guard.failures - 1)
+	}
+	if delay > maxLoginDelay {
+		delay = maxLoginDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// succeed records a successful login, which resets the failure count of the username
+func (guard *loginGuard) succeed(ctx context.Context) error {
+	_, err := guard.server.store.CreateLoginAttempt(ctx, db.CreateLoginAttemptParams{
+		Username:     guard.username,
+		ClientIp:     guard.clientIP,
+		IsSuccessful: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %s", err)
+	}
+	return nil
+}
+
+// reject records a failed login and returns err, the error for the client
+func (guard *loginGuard) reject(ctx context.Context, err error) error {
+	if failErr := guard.fail(ctx); failErr != nil {
+		return failErr
+	}
+	return err
+}
+
+// fail records a failed login and locks out the username or the client IP once they reach their threshold
+func (guard *loginGuard) fail(ctx context.Context) error {
+	config := guard.server.config
+
+	_, err := guard.server.store.CreateLoginAttempt(ctx, db.CreateLoginAttemptParams{
+		Username:     guard.username,
+		ClientIp:     guard.clientIP,
+		IsSuccessful: false,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %s", err)
+	}
+
+	failures, err := guard.server.store.CountLoginFailuresByUsername(ctx, db.CountLoginFailuresByUsernameParams{
+		Username: guard.username,
+		Since:    guard.usernameSince,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count login failures: %s", err)
+	}
+
+	if config.LoginLockoutThreshold > 0 && failures >= config.LoginLockoutThreshold {
+		lockout, err := guard.lockOut(ctx, lockoutSubjectUsername, guard.username, failures)
+		if err != nil {
+			return err
+		}
+		guard.notifyLockout(ctx, lockout)
+	}
+
+	failures, err = guard.server.store.CountLoginFailuresByClientIp(ctx, db.CountLoginFailuresByClientIpParams{
+		ClientIp: guard.clientIP,
+		Since:    guard.clientIPSince,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count login failures: %s", err)
+	}
+
+	if config.LoginIPLockoutThreshold > 0 && failures >= config.LoginIPLockoutThreshold {
+		if _, err := guard.lockOut(ctx, lockoutSubjectClientIP, guard.clientIP, failures); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (guard *loginGuard) lockOut(ctx context.Context, subjectType string, subject string, failures int64) (db.LoginLockout, error) {
+	lockout, err := guard.server.store.CreateLoginLockout(ctx, db.CreateLoginLockoutParams{
+		SubjectType:    subjectType,
+		Subject:        subject,
+		FailedAttempts: failures,
+		LockedUntil:    time.Now().Add(guard.server.config.LoginLockoutDuration),
+	})
+	if err != nil {
+		return lockout, status.Errorf(codes.Internal, "failed to create login lockout: %s", err)
+	}
+
+	log.Warn().
+		Str("subject_type", subjectType).
+		Str("subject", subject).
+		Int64("failed_attempts", failures).
+		Time("locked_until", lockout.LockedUntil).
+		Msg("login locked out")
+	return lockout, nil
+}
+
+// notifyLockout emails the owner of a locked out username, if the user exists.
+// A failure to enqueue the email is logged only, since the lockout itself is already in place.
+func (guard *loginGuard) notifyLockout(ctx context.Context, lockout db.LoginLockout) {
+	_, err := guard.server.store.GetUser(ctx, guard.username)
+	if err != nil {
+		if err != db.ErrRecordNotFound {
+			log.Error().Err(err).Msg("failed to get locked out user")
+		}
+		return
+	}
+
+	taskPayload := &worker.PayloadSendLockoutNotification{
+		Username:       guard.username,
+		ClientIP:       guard.clientIP,
+		FailedAttempts: lockout.FailedAttempts,
+		LockedUntil:    lockout.LockedUntil,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	err = guard.server.taskDistributor.DistributeTaskSendLockoutNotification(ctx, taskPayload, opts...)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to send lockout notification")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/token"
@@ -14,23 +15,40 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-var tokenSymmetricKey = util.RandomString(32)
+var tokenSigningKey = newTokenSigningKey()
 var accessTokenDuration = time.Minute
 
 func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
 	config := util.Config{
-		TokenSymmetricKey: tokenSymmetricKey,
+		TokenSigningKey:     tokenSigningKey,
 		AccessTokenDuration: accessTokenDuration,
 	}
 
 	server, err := NewServer(config, store, taskDistributor)
 	require.NoError(t, err)
 
+	// tests that don't care about password changes and revocations don't expect their lookups
+	server.passwordChecker = token.NewPasswordChangeChecker(func(ctx context.Context, username string) (time.Time, error) {
+		return time.Time{}, nil
+	}, time.Minute)
+	server.revocationChecker = token.NewRevocationChecker(func(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+		return false, nil
+	}, time.Minute)
+
 	return server
 }
 
-func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, duration time.Duration) context.Context {
-	accessToken, _, err := tokenMaker.CreateToken(username, duration)
+// newTokenSigningKey generates the signing key the test servers share, so that tokens made by one verify on another
+func newTokenSigningKey() string {
+	signingKey, _, err := token.GenerateSigningKey()
+	if err != nil {
+		panic(err)
+	}
+	return signingKey
+}
+
+func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, role string, duration time.Duration) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(username, role, duration, token.TokenTypeAccessToken)
 	require.NoError(t, err)
 	bearerToken := fmt.Sprintf("%s %s", authorizationBearer, accessToken)
 	md := metadata.MD{
@@ -2,7 +2,10 @@ package gapi
 
 import (
 	"context"
+	"net"
+	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -13,6 +16,18 @@ const (
 	grpcUserAgentHeader = "user-agent"
 )
 
+// contentDispositionHeader is set by RPCs that return a file to download
+const contentDispositionHeader = "content-disposition"
+
+// OutgoingHeaderMatcher decides which response headers of the RPCs the HTTP gateway forwards.
+// Content-Disposition is forwarded as is, every other header with the default Grpc-Metadata- prefix.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == contentDispositionHeader {
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 type Metadata struct {
 	UserAgent string
 	ClientIP  string
@@ -28,10 +43,17 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 			m.UserAgent = userAgents[0]
 		}
 		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
-			m.ClientIP = clientIPs[0]
+			// the client controls every entry but the last, which the gateway appends with the address
+			// the request came from, so only that one can be trusted to count failed logins by
+			hops := strings.Split(clientIPs[len(clientIPs)-1], ",")
+			m.ClientIP = strings.TrimSpace(hops[len(hops)-1])
 		}
 		if p, ok := peer.FromContext(ctx); ok {
 			m.ClientIP = p.Addr.String()
+			// drop the port so that attempts from the same host can be told apart from others
+			if host, _, err := net.SplitHostPort(m.ClientIP); err == nil {
+				m.ClientIP = host
+			}
 		}
 	}
 	return m
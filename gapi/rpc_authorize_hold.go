@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AuthorizeHold(ctx context.Context, req *pb.AuthorizeHoldRequest) (*pb.AuthorizeHoldResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAuthorizeHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.validAccount(ctx, req.GetAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	// a capture moves the held amount as it is, so both accounts must share the currency
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.AuthorizeHoldTx(ctx, db.AuthorizeHoldTxParams{
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      req.GetAmount(),
+		Sender:      account.Owner,
+		Recipient:   toAccount.Owner,
+		ExpiresAt:   req.GetExpiresAt().AsTime(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrInvalidHold) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authorize hold: %s", err)
+	}
+
+	rsp := &pb.AuthorizeHoldResponse{
+		Hold:    ConvertHold(result.Hold),
+		Account: ConvertAccount(result.Account),
+	}
+	return rsp, nil
+}
+
+func validateAuthorizeHoldRequest(req *pb.AuthorizeHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("accountId", err))
+	}
+	if err := val.ValidateId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("toAccountId", err))
+	}
+	if req.GetAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("toAccountId", fmt.Errorf("must differ from accountId")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if err := req.GetExpiresAt().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("expiresAt", err))
+	} else if expiresIn := time.Until(req.GetExpiresAt().AsTime()); expiresIn <= 0 || expiresIn > db.MaxHoldDuration {
+		violations = append(violations, fieldViolation("expiresAt", fmt.Errorf("must be in the future and at most %s away", db.MaxHoldDuration)))
+	}
+	return violations
+}
@@ -0,0 +1,172 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuthorizeHoldAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(util.RandomOwner())
+	account2.ID = account1.ID + 1
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+
+	amount := int64(500)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	heldAccount := account1
+	heldAccount.HeldBalance = amount
+	heldAccount.AvailableBalance = account1.Balance - amount
+
+	result := db.HoldTxResult{
+		Hold: db.Hold{
+			ID:          util.RandomInt(1, 1000),
+			AccountID:   account1.ID,
+			ToAccountID: account2.ID,
+			Sender:      account1.Owner,
+			Recipient:   account2.Owner,
+			Amount:      amount,
+			Status:      db.HoldStatusAuthorized,
+			ExpiresAt:   expiresAt,
+		},
+		Account: heldAccount,
+	}
+
+	validRequest := func() *pb.AuthorizeHoldRequest {
+		return &pb.AuthorizeHoldRequest{
+			AccountId:   account1.ID,
+			ToAccountId: account2.ID,
+			Amount:      amount,
+			Currency:    util.USD,
+			ExpiresAt:   timestamppb.New(expiresAt),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AuthorizeHoldRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AuthorizeHoldResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.AuthorizeHoldTxParams{
+					AccountID:   account1.ID,
+					ToAccountID: account2.ID,
+					Amount:      amount,
+					Sender:      account1.Owner,
+					Recipient:   account2.Owner,
+					ExpiresAt:   expiresAt,
+				}
+				store.EXPECT().
+					AuthorizeHoldTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.Hold.ID, res.GetHold().GetId())
+				require.Equal(t, db.HoldStatusAuthorized, res.GetHold().GetStatus())
+				require.Equal(t, account1.Balance, res.GetAccount().GetBalance())
+				require.Equal(t, amount, res.GetAccount().GetHeldBalance())
+				require.Equal(t, account1.Balance-amount, res.GetAccount().GetAvailableBalance())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().
+					AuthorizeHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.HoldTxResult{}, db.ErrInsufficientFunds)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AuthorizeHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "other", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "ExpiredAlready",
+			req: func() *pb.AuthorizeHoldRequest {
+				req := validRequest()
+				req.ExpiresAt = timestamppb.New(time.Now().Add(-time.Minute))
+				return req
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeHoldResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.AuthorizeHold(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduledTransfer, err := server.store.CancelScheduledTransfer(ctx, db.CancelScheduledTransferParams{
+		ID:    req.GetId(),
+		Owner: authPayload.Username,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "active scheduled transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled transfer: %s", err)
+	}
+
+	rsp := &pb.CancelScheduledTransferResponse{
+		ScheduledTransfer: ConvertScheduledTransfer(scheduledTransfer),
+	}
+	return rsp, nil
+}
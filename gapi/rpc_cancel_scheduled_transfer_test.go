@@ -0,0 +1,151 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelScheduledTransferAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	otherUser := randomUser(t, util.RandomString(6))
+	scheduledTransfer := randomScheduledTransfer(user.Username)
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CancelScheduledTransferParams{
+					ID:    scheduledTransfer.ID,
+					Owner: user.Username,
+				}
+				cancelled := scheduledTransfer
+				cancelled.Status = db.ScheduledTransferStatusCancelled
+				store.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(cancelled, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, scheduledTransfer.ID, res.GetScheduledTransfer().GetId())
+				require.Equal(t, db.ScheduledTransferStatusCancelled, res.GetScheduledTransfer().GetStatus())
+			},
+		},
+		{
+			// only active schedules can be cancelled, so one cancelled before is not found
+			name: "AlreadyCancelled",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ScheduledTransfer{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "ScheduleOfAnotherUser",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CancelScheduledTransferParams{
+					ID:    scheduledTransfer.ID,
+					Owner: otherUser.Username,
+				}
+				store.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ScheduledTransfer{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ScheduledTransfer{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidID",
+			id:   0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelScheduledTransferResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CancelScheduledTransfer(ctx, &pb.CancelScheduledTransferRequest{Id: tc.id})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCaptureHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hold, err := server.recipientHold(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, db.CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: req.GetAmount(),
+	})
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr)
+		}
+		if errors.Is(err, db.ErrHoldNotAuthorized) || errors.Is(err, db.ErrCaptureExceedsHold) ||
+			errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrSystemAccount) ||
+			errors.Is(err, db.ErrAccountNotActive) || errors.Is(err, db.ErrTransferLimitUnchecked) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to capture hold: %s", err)
+	}
+
+	rsp := &pb.CaptureHoldResponse{
+		Hold:      ConvertHold(result.Hold),
+		Transfer:  ConvertTransfer(result.Transfer),
+		FromEntry: ConvertEntry(result.FromEntry),
+		ToEntry:   ConvertEntry(result.ToEntry),
+	}
+	return rsp, nil
+}
+
+// recipientHold loads a hold that the caller may capture or void, which are the recipient and staff
+func (server *Server) recipientHold(ctx context.Context, authPayload *token.Payload, id int64) (db.Hold, error) {
+	hold, err := server.store.GetHold(ctx, id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return hold, status.Errorf(codes.NotFound, "hold not found")
+		}
+		return hold, status.Errorf(codes.Internal, "failed to get hold: %s", err)
+	}
+
+	if hold.Recipient != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+		return hold, status.Errorf(codes.PermissionDenied, "only the recipient or a banker can capture or void the hold")
+	}
+	return hold, nil
+}
+
+func validateCaptureHoldRequest(req *pb.CaptureHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,106 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.AccountsWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.store.GetAccount(ctx, req.GetId())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	result, err := server.updateAccountStatus(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: db.AccountStatusActive,
+		ToStatus:   db.AccountStatusClosed,
+		ChangedBy:  authPayload.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CloseAccountResponse{
+		Account:      ConvertAccount(result.Account),
+		StatusChange: ConvertAccountStatusChange(result.StatusChange),
+	}
+	return rsp, nil
+}
+
+// changeAccountStatus moves an account from one status to another on behalf of a banker, who must give a reason
+func (server *Server) changeAccountStatus(
+	ctx context.Context,
+	id int64,
+	reason string,
+	fromStatus string,
+	toStatus string,
+) (db.UpdateAccountStatusTxResult, error) {
+	authPayload, err := server.authorizeUser(ctx, bankerRoles)
+	if err != nil {
+		return db.UpdateAccountStatusTxResult{}, err
+	}
+
+	violations := validateChangeAccountStatusRequest(id, reason)
+	if violations != nil {
+		return db.UpdateAccountStatusTxResult{}, invalidArgumentError(violations)
+	}
+
+	return server.updateAccountStatus(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  id,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Reason:     reason,
+		ChangedBy:  authPayload.Username,
+	})
+}
+
+func (server *Server) updateAccountStatus(ctx context.Context, arg db.UpdateAccountStatusTxParams) (db.UpdateAccountStatusTxResult, error) {
+	result, err := server.store.UpdateAccountStatusTx(ctx, arg)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return result, status.Errorf(codes.NotFound, "account not found")
+		}
+		if errors.Is(err, db.ErrAccountStatusChange) || errors.Is(err, db.ErrAccountNotEmpty) || errors.Is(err, db.ErrSystemAccount) {
+			return result, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return result, status.Errorf(codes.Internal, "failed to update account status: %s", err)
+	}
+	return result, nil
+}
+
+func validateChangeAccountStatusRequest(id int64, reason string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	// unlike other reasons, the one of a status change is required
+	if err := val.ValidateString(reason, 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+	return violations
+}
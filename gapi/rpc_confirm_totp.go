@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/totp"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ConfirmTotp(ctx context.Context, req *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateConfirmTotpRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	credential, err := server.store.GetTotpCredential(ctx, authPayload.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enrolled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get totp credential: %s", err)
+	}
+
+	if credential.IsEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	counter, ok := totp.Validate(credential.Secret, req.GetCode(), time.Now())
+	if !ok {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("code", errInvalidAuthenticationCode)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	recoveryCodes, err := totp.GenerateRecoveryCodes(totp.RecoveryCodeCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %s", err)
+	}
+
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedRecoveryCodes[i] = totp.HashRecoveryCode(code)
+	}
+
+	_, err = server.store.EnableTotpTx(ctx, db.EnableTotpTxParams{
+		Username:            authPayload.Username,
+		LastUsedCounter:     counter,
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to enable totp: %s", err)
+	}
+
+	// the plain recovery codes are only ever shown here
+	rsp := &pb.ConfirmTotpResponse{
+		RecoveryCodes: recoveryCodes,
+	}
+	return rsp, nil
+}
+
+func validateConfirmTotpRequest(req *pb.ConfirmTotpRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTotpCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+	return violations
+}
@@ -0,0 +1,180 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/totp"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEnrollTotpAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+
+	store := mockdb.NewMockStore(storeCtrl)
+	store.EXPECT().
+		UpsertTotpCredential(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, arg db.UpsertTotpCredentialParams) (db.TotpCredential, error) {
+			require.Equal(t, user.Username, arg.Username)
+			return db.TotpCredential{Username: arg.Username, Secret: arg.Secret}, nil
+		})
+
+	server := newTestServer(t, store, nil)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.DepositorRole, time.Minute)
+
+	res, err := server.EnrollTotp(ctx, &pb.EnrollTotpRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.GetSecret())
+
+	uri, err := url.Parse(res.GetProvisioningUri())
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, res.GetSecret(), uri.Query().Get("secret"))
+}
+
+func TestConfirmTotpAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	credential := randomTotpCredential(t, user.Username)
+	credential.IsEnabled = false
+
+	testCases := []struct {
+		name          string
+		code          func(t *testing.T) string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ConfirmTotpResponse, err error)
+	}{
+		{
+			name: "OK",
+			code: func(t *testing.T) string {
+				return currentTotpCode(t, credential.Secret)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().
+					EnableTotpTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.EnableTotpTxParams) (db.EnableTotpTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.InDelta(t, totp.Counter(time.Now()), arg.LastUsedCounter, 1)
+						require.Len(t, arg.HashedRecoveryCodes, totp.RecoveryCodeCount)
+						return db.EnableTotpTxResult{}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTotpResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetRecoveryCodes(), totp.RecoveryCodeCount)
+			},
+		},
+		{
+			name: "WrongCode",
+			code: func(t *testing.T) string {
+				return wrongTotpCode(t, credential.Secret)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().EnableTotpTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTotpResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "AlreadyEnabled",
+			code: func(t *testing.T) string {
+				return currentTotpCode(t, credential.Secret)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				enabled := credential
+				enabled.IsEnabled = true
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(enabled, nil)
+				store.EXPECT().EnableTotpTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTotpResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NotEnrolled",
+			code: func(t *testing.T) string {
+				return currentTotpCode(t, credential.Secret)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				store.EXPECT().EnableTotpTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTotpResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			code: func(t *testing.T) string {
+				return currentTotpCode(t, credential.Secret)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmTotpResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+
+			store := mockdb.NewMockStore(storeCtrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+
+			res, err := server.ConfirmTotp(ctx, &pb.ConfirmTotpRequest{Code: tc.code(t)})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateApiKeyRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API key id: %s", err)
+	}
+
+	key, hashedSecret, err := token.NewAPIKey(id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API key: %s", err)
+	}
+
+	apiKey, err := server.store.CreateApiKey(ctx, db.CreateApiKeyParams{
+		ID:           id,
+		Username:     authPayload.Username,
+		Name:         req.GetName(),
+		HashedSecret: hashedSecret,
+		Scopes:       req.GetScopes(),
+		ExpiresAt:    req.GetExpiresAt().AsTime(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API key: %s", err)
+	}
+
+	rsp := &pb.CreateApiKeyResponse{
+		ApiKey: ConvertApiKey(apiKey),
+		Key:    key,
+	}
+	return rsp, nil
+}
+
+func validateCreateApiKeyRequest(req *pb.CreateApiKeyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetName(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+	if err := val.ValidateScopes(req.GetScopes()); err != nil {
+		violations = append(violations, fieldViolation("scopes", err))
+	}
+	if err := validateApiKeyExpiry(req); err != nil {
+		violations = append(violations, fieldViolation("expiresAt", err))
+	}
+	return violations
+}
+
+func validateApiKeyExpiry(req *pb.CreateApiKeyRequest) error {
+	if req.ExpiresAt == nil {
+		return fmt.Errorf("must be set")
+	}
+	if err := req.GetExpiresAt().CheckValid(); err != nil {
+		return err
+	}
+
+	expiresAt := req.GetExpiresAt().AsTime()
+	if !expiresAt.After(time.Now()) {
+		return fmt.Errorf("must be in the future")
+	}
+	if expiresAt.After(time.Now().Add(token.MaxAPIKeyDuration)) {
+		return fmt.Errorf("must be within %s", token.MaxAPIKeyDuration)
+	}
+	return nil
+}
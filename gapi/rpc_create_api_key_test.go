@@ -0,0 +1,152 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateApiKeyAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	expiresAt := time.Now().Add(24 * time.Hour).UTC()
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateApiKeyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateApiKeyResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateApiKeyRequest{
+				Name:      "payroll",
+				Scopes:    []string{util.AccountsReadScope, util.TransfersWriteScope},
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateApiKeyParams) (db.ApiKey, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, "payroll", arg.Name)
+						require.Equal(t, []string{util.AccountsReadScope, util.TransfersWriteScope}, arg.Scopes)
+						require.True(t, expiresAt.Equal(arg.ExpiresAt))
+						return db.ApiKey{
+							ID:           arg.ID,
+							Username:     arg.Username,
+							Name:         arg.Name,
+							HashedSecret: arg.HashedSecret,
+							Scopes:       arg.Scopes,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateApiKeyResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+
+				id, _, err := token.ParseAPIKey(res.GetKey())
+				require.NoError(t, err)
+				require.Equal(t, res.GetApiKey().GetId(), id.String())
+			},
+		},
+		{
+			name: "InvalidScopes",
+			req: &pb.CreateApiKeyRequest{
+				Name:      "payroll",
+				Scopes:    []string{"users:write"},
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateApiKeyResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "MissingExpiry",
+			req: &pb.CreateApiKeyRequest{
+				Name:   "payroll",
+				Scopes: []string{util.AccountsReadScope},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateApiKeyResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "ExpiryTooLate",
+			req: &pb.CreateApiKeyRequest{
+				Name:      "payroll",
+				Scopes:    []string{util.AccountsReadScope},
+				ExpiresAt: timestamppb.New(time.Now().Add(token.MaxAPIKeyDuration + time.Hour)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateApiKeyResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateApiKeyRequest{
+				Name:      "payroll",
+				Scopes:    []string{util.AccountsReadScope},
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateApiKeyResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateApiKey(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
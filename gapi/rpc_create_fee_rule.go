@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateFeeRule(ctx context.Context, req *pb.CreateFeeRuleRequest) (*pb.CreateFeeRuleResponse, error) {
+	_, err := server.authorizeUser(ctx, adminRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateFeeRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rule, err := server.store.CreateFeeRule(ctx, db.CreateFeeRuleParams{
+		Currency:     req.GetCurrency(),
+		TransferType: req.GetTransferType(),
+		MinAmount:    req.GetMinAmount(),
+		FlatFee:      req.GetFlatFee(),
+		BasisPoints:  req.GetBasisPoints(),
+		MaxFee:       req.GetMaxFee(),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "a %s rule in %s with min amount %d already exists", req.GetTransferType(), req.GetCurrency(), req.GetMinAmount())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create fee rule: %s", err)
+	}
+
+	rsp := &pb.CreateFeeRuleResponse{
+		FeeRule: ConvertFeeRule(rule),
+	}
+	return rsp, nil
+}
+
+func validateCreateFeeRuleRequest(req *pb.CreateFeeRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if !db.IsSupportedTransferType(req.GetTransferType()) {
+		violations = append(violations, fieldViolation("transferType", fmt.Errorf("must be internal, cross_currency or scheduled")))
+	}
+	if req.GetMinAmount() < 0 {
+		violations = append(violations, fieldViolation("minAmount", fmt.Errorf("must not be negative")))
+	}
+	if req.GetFlatFee() < 0 {
+		violations = append(violations, fieldViolation("flatFee", fmt.Errorf("must not be negative")))
+	}
+	if req.GetBasisPoints() < 0 || req.GetBasisPoints() > db.BasisPointsPerUnit {
+		violations = append(violations, fieldViolation("basisPoints", fmt.Errorf("must be between 0 and %d", db.BasisPointsPerUnit)))
+	}
+	if req.GetMaxFee() < 0 {
+		violations = append(violations, fieldViolation("maxFee", fmt.Errorf("must not be negative")))
+	}
+	return violations
+}
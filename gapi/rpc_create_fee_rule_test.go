@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateFeeRuleAPI(t *testing.T) {
+	admin := randomUser(t, util.RandomString(6))
+	rule := db.FeeRule{
+		ID:           util.RandomInt(1, 1000),
+		Currency:     util.USD,
+		TransferType: db.TransferTypeInternal,
+		MinAmount:    10000,
+		FlatFee:      25,
+		BasisPoints:  50,
+		MaxFee:       500,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateFeeRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateFeeRuleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateFeeRuleRequest{
+				Currency:     rule.Currency,
+				TransferType: rule.TransferType,
+				MinAmount:    rule.MinAmount,
+				FlatFee:      rule.FlatFee,
+				BasisPoints:  rule.BasisPoints,
+				MaxFee:       rule.MaxFee,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateFeeRuleParams{
+					Currency:     rule.Currency,
+					TransferType: rule.TransferType,
+					MinAmount:    rule.MinAmount,
+					FlatFee:      rule.FlatFee,
+					BasisPoints:  rule.BasisPoints,
+					MaxFee:       rule.MaxFee,
+				}
+				store.EXPECT().
+					CreateFeeRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rule, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeeRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, rule.ID, res.GetFeeRule().GetId())
+				require.Equal(t, rule.BasisPoints, res.GetFeeRule().GetBasisPoints())
+			},
+		},
+		{
+			name: "DuplicateRule",
+			req: &pb.CreateFeeRuleRequest{
+				Currency:     rule.Currency,
+				TransferType: rule.TransferType,
+				MinAmount:    rule.MinAmount,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateFeeRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FeeRule{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "InvalidTransferType",
+			req: &pb.CreateFeeRuleRequest{
+				Currency:     rule.Currency,
+				TransferType: "wire",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidBasisPoints",
+			req: &pb.CreateFeeRuleRequest{
+				Currency:     rule.Currency,
+				TransferType: rule.TransferType,
+				BasisPoints:  db.BasisPointsPerUnit + 1,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "Banker",
+			req: &pb.CreateFeeRuleRequest{
+				Currency:     rule.Currency,
+				TransferType: rule.TransferType,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateFeeRule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	// scheduled transfers run without a live exchange rate, so they cannot convert currencies
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Kind != db.AccountKindCustomer || toAccount.Kind != db.AccountKindCustomer {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrSystemAccount)
+	}
+
+	if fromAccount.Status != db.AccountStatusActive || toAccount.Status != db.AccountStatusActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrAccountNotActive)
+	}
+
+	var dayOfMonth int32
+	if req.GetFrequency() == util.MonthlyFrequency {
+		dayOfMonth = req.GetDayOfMonth()
+	}
+
+	scheduledTransfer, err := server.store.CreateScheduledTransfer(ctx, db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.GetAmount(),
+		Frequency:     req.GetFrequency(),
+		DayOfMonth:    dayOfMonth,
+		NextRunAt:     util.FirstRun(req.GetFrequency(), dayOfMonth, req.GetStartAt().AsTime()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled transfer: %s", err)
+	}
+
+	rsp := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: ConvertScheduledTransfer(scheduledTransfer),
+	}
+	return rsp, nil
+}
+
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("fromAccountId", err))
+	}
+	if err := val.ValidateId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("toAccountId", err))
+	}
+	if req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("toAccountId", fmt.Errorf("must differ from fromAccountId")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if err := val.ValidateFrequency(req.GetFrequency()); err != nil {
+		violations = append(violations, fieldViolation("frequency", err))
+	}
+	if req.GetFrequency() == util.MonthlyFrequency {
+		if err := val.ValidateDayOfMonth(req.GetDayOfMonth()); err != nil {
+			violations = append(violations, fieldViolation("dayOfMonth", err))
+		}
+	}
+	if err := req.GetStartAt().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("startAt", err))
+	} else if !req.GetStartAt().AsTime().After(time.Now()) {
+		violations = append(violations, fieldViolation("startAt", fmt.Errorf("must be in the future")))
+	}
+	return violations
+}
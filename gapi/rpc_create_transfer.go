@@ -0,0 +1,136 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersWriteScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	// the destination account may hold a different currency, the amount is then converted
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.GetAmount(),
+		Sender:        fromAccount.Owner,
+		Recipient:     toAccount.Owner,
+	}
+
+	if toAccount.Currency != fromAccount.Currency {
+		conversion, err := fx.Convert(ctx, server.rateProvider, req.GetAmount(), fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) {
+				return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to convert amount: %s", err)
+		}
+
+		arg.ToAmount = conversion.ToAmount
+		arg.ExchangeRate = conversion.RateString()
+	}
+
+	if req.IdempotencyKey != nil {
+		arg.IdempotencyKey = req.GetIdempotencyKey()
+		arg.RequestHash = db.HashTransferRequest(req.GetFromAccountId(), req.GetToAccountId(), req.GetAmount(), req.GetCurrency())
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr)
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrIdempotencyKeyReused) ||
+			errors.Is(err, db.ErrSystemAccount) || errors.Is(err, db.ErrAccountNotActive) ||
+			errors.Is(err, db.ErrTransferLimitUnchecked) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create transfer: %s", err)
+	}
+
+	rsp := &pb.CreateTransferResponse{
+		Transfer:    ConvertTransfer(result.Transfer),
+		FromAccount: ConvertAccount(result.FromAccount),
+		FromEntry:   ConvertEntry(result.FromEntry),
+		ToEntry:     ConvertEntry(result.ToEntry),
+	}
+	if result.Transfer.Fee > 0 {
+		rsp.FeeEntry = ConvertEntry(result.FeeEntry)
+	}
+	return rsp, nil
+}
+
+// validAccount loads the account and checks that it is in the given currency.
+// An empty currency skips the currency check.
+func (server *Server) validAccount(ctx context.Context, accountID int64, currency string) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if currency != "" && account.Currency != currency {
+		return account, status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+
+	return account, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("fromAccountId", err))
+	}
+	if err := val.ValidateId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("toAccountId", err))
+	}
+	if req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("toAccountId", fmt.Errorf("must differ from fromAccountId")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if req.IdempotencyKey != nil {
+		if err := val.ValidateString(req.GetIdempotencyKey(), 1, 255); err != nil {
+			violations = append(violations, fieldViolation("idempotencyKey", err))
+		}
+	}
+	return violations
+}
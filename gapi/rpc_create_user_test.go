@@ -185,6 +185,7 @@ func randomUser(t *testing.T, password string) db.User {
 		Email:             util.RandomEmail(),
 		FullName:          util.RandomString(8),
 		HashedPassword:    hash,
+		Role:              util.DepositorRole,
 		PasswordChangedAt: time.Time{},
 		CreatedAt:         time.Time{},
 	}
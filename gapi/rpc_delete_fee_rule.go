@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteFeeRule(ctx context.Context, req *pb.DeleteFeeRuleRequest) (*pb.DeleteFeeRuleResponse, error) {
+	_, err := server.authorizeUser(ctx, adminRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	rule, err := server.store.DeleteFeeRule(ctx, req.GetId())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "fee rule not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete fee rule: %s", err)
+	}
+
+	rsp := &pb.DeleteFeeRuleResponse{
+		FeeRule: ConvertFeeRule(rule),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,146 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteFeeRuleAPI(t *testing.T) {
+	admin := util.RandomOwner()
+	rule := randomFeeRule()
+
+	testCases := []struct {
+		name          string
+		req           *pb.DeleteFeeRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.DeleteFeeRuleRequest{
+				Id: rule.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteFeeRule(gomock.Any(), gomock.Eq(rule.ID)).
+					Times(1).
+					Return(rule, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, rule.ID, res.GetFeeRule().GetId())
+			},
+		},
+		{
+			name: "NotFound",
+			req: &pb.DeleteFeeRuleRequest{
+				Id: rule.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteFeeRule(gomock.Any(), gomock.Eq(rule.ID)).
+					Times(1).
+					Return(db.FeeRule{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidID",
+			req: &pb.DeleteFeeRuleRequest{
+				Id: 0,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteFeeRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorDenied",
+			req: &pb.DeleteFeeRuleRequest{
+				Id: rule.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteFeeRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerDenied",
+			req: &pb.DeleteFeeRuleRequest{
+				Id: rule.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteFeeRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteFeeRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.DeleteFeeRule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
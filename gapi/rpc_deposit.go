@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	_, err := server.authorizeUser(ctx, bankerRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDepositRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.validAccount(ctx, req.GetAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.DepositTx(ctx, db.ExternalTxParams{
+		AccountID:   account.ID,
+		Amount:      req.GetAmount(),
+		Reference:   req.GetReference(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrSystemAccount) || errors.Is(err, db.ErrAccountNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "reference %s was already posted to the account", req.GetReference())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to deposit: %s", err)
+	}
+
+	rsp := &pb.DepositResponse{
+		Account: ConvertAccount(result.Account),
+		Entry:   ConvertEntry(result.Entry),
+	}
+	return rsp, nil
+}
+
+func validateDepositRequest(req *pb.DepositRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("accountId", err))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	if err := val.ValidateReference(req.GetReference()); err != nil {
+		violations = append(violations, fieldViolation("reference", err))
+	}
+	if err := val.ValidateDescription(req.GetDescription()); err != nil {
+		violations = append(violations, fieldViolation("description", err))
+	}
+	return violations
+}
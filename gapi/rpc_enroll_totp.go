@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/totp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// totpIssuer is the name authenticator apps display next to the account
+const totpIssuer = "Simple Bank"
+
+func (server *Server) EnrollTotp(ctx context.Context, req *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %s", err)
+	}
+
+	// an existing enrollment is only replaced while it has not been confirmed yet
+	credential, err := server.store.UpsertTotpCredential(ctx, db.UpsertTotpCredentialParams{
+		Username: authPayload.Username,
+		Secret:   secret,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to enroll totp: %s", err)
+	}
+
+	rsp := &pb.EnrollTotpResponse{
+		Secret:          credential.Secret,
+		ProvisioningUri: totp.ProvisioningURI(totpIssuer, credential.Username, credential.Secret),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/statement"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ExportStatement(ctx context.Context, req *pb.ExportStatementRequest) (*httpbody.HttpBody, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.AccountsReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateExportStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, result, err := server.getStatement(ctx, authPayload, req.GetAccountId(), req.GetFromTime(), req.GetToTime())
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := server.store.GetUser(ctx, account.Owner)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "account owner not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account owner: %s", err)
+	}
+
+	file, err := statement.Export(req.GetFormat(), statement.Statement{
+		Account:           account,
+		OwnerName:         owner.FullName,
+		StatementTxResult: result,
+		CreatedAt:         time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export statement: %s", err)
+	}
+
+	// the gateway turns the header into Content-Disposition, so that browsers download the file
+	header := metadata.Pairs(contentDispositionHeader, fmt.Sprintf("attachment; filename=%q", file.Name))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set header: %s", err)
+	}
+
+	rsp := &httpbody.HttpBody{
+		ContentType: file.ContentType,
+		Data:        file.Content,
+	}
+	return rsp, nil
+}
+
+func validateExportStatementRequest(req *pb.ExportStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("accountId", err))
+	}
+	if err := req.GetFromTime().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("fromTime", err))
+	}
+	if err := req.GetToTime().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("toTime", err))
+	}
+	if err := val.ValidateStatementFormat(req.GetFormat()); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+	return violations
+}
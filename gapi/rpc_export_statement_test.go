@@ -0,0 +1,167 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// headerStream records the headers an RPC sets, in place of a real transport stream
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (stream *headerStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func TestExportStatementAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account := randomAccount(user.Username)
+
+	toTime := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	statement := db.StatementTxResult{
+		AccountID:      account.ID,
+		FromTime:       fromTime,
+		ToTime:         toTime,
+		OpeningBalance: 1000,
+		ClosingBalance: 1000,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ExportStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *httpbody.HttpBody, header metadata.MD, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ExportStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(fromTime),
+				ToTime:    timestamppb.New(toTime),
+				Format:    util.CSVFormat,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(statement, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(account.Owner)).
+					Times(1).
+					Return(user, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, header metadata.MD, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "text/csv", res.GetContentType())
+				require.Contains(t, string(res.GetData()), "opening_balance")
+				require.Equal(t, []string{`attachment; filename="statement-` +
+					strconv.FormatInt(account.ID, 10) + `-20230901-20231001.csv"`}, header.Get(contentDispositionHeader))
+			},
+		},
+		{
+			name: "UnsupportedFormat",
+			req: &pb.ExportStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(fromTime),
+				ToTime:    timestamppb.New(toTime),
+				Format:    "pdf",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, header metadata.MD, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.ExportStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(fromTime),
+				ToTime:    timestamppb.New(toTime),
+				Format:    util.Camt053Format,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "other", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, header metadata.MD, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(tc.buildContext(t, server.tokenMaker), stream)
+			res, err := server.ExportStatement(ctx, tc.req)
+			tc.checkResponse(t, res, stream.header, err)
+		})
+	}
+}
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	header, ok := OutgoingHeaderMatcher(contentDispositionHeader)
+	require.True(t, ok)
+	require.Equal(t, "Content-Disposition", header)
+
+	header, ok = OutgoingHeaderMatcher("x-custom")
+	require.True(t, ok)
+	require.Equal(t, "Grpc-Metadata-x-custom", header)
+}
@@ -0,0 +1,21 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+)
+
+func (server *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	result, err := server.changeAccountStatus(ctx, req.GetId(), req.GetReason(), db.AccountStatusActive, db.AccountStatusFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.FreezeAccountResponse{
+		Account:      ConvertAccount(result.Account),
+		StatusChange: ConvertAccountStatusChange(result.StatusChange),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) FreezeUser(ctx context.Context, req *pb.FreezeUserRequest) (*pb.FreezeUserResponse, error) {
+	if err := server.authorizeUserFreeze(ctx, req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.FreezeUserTx(ctx, req.GetUsername())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if errors.Is(err, db.ErrSystemUser) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to freeze user: %s", err)
+	}
+
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	rsp := &pb.FreezeUserResponse{
+		User: ConvertUser(result.User),
+	}
+	return rsp, nil
+}
+
+// authorizeUserFreeze checks that the caller may freeze or unfreeze the user.
+// Bankers may only freeze depositors, and nobody may freeze themselves or the system user.
+func (server *Server) authorizeUserFreeze(ctx context.Context, username string) error {
+	authPayload, err := server.authorizeUser(ctx, bankerRoles)
+	if err != nil {
+		return err
+	}
+
+	if err := val.ValidateUsername(username); err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("username", err)}
+		return invalidArgumentError(violations)
+	}
+
+	if username == authPayload.Username {
+		return status.Errorf(codes.FailedPrecondition, "cannot freeze or unfreeze yourself")
+	}
+
+	if username == db.SystemUsername {
+		return status.Errorf(codes.FailedPrecondition, "%s", db.ErrSystemUser)
+	}
+
+	user, err := server.store.GetUser(ctx, username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if user.Role != util.DepositorRole && authPayload.Role != util.AdminRole {
+		return status.Errorf(codes.PermissionDenied, "only admins can freeze or unfreeze staff")
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFreezeUserAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	staff := randomUser(t, util.RandomString(6))
+	staff.Role = util.BankerRole
+	banker := util.RandomOwner()
+
+	frozenUser := user
+	frozenUser.IsFrozen = true
+
+	testCases := []struct {
+		name          string
+		req           *pb.FreezeUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.FreezeUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.FreezeUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.FreezeUserTxResult{User: frozenUser}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.True(t, res.GetUser().GetIsFrozen())
+			},
+		},
+		{
+			name: "AdminFreezesStaff",
+			req: &pb.FreezeUserRequest{
+				Username: staff.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				frozenStaff := staff
+				frozenStaff.IsFrozen = true
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(staff.Username)).
+					Times(1).
+					Return(staff, nil)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Eq(staff.Username)).
+					Times(1).
+					Return(db.FreezeUserTxResult{User: frozenStaff}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.True(t, res.GetUser().GetIsFrozen())
+			},
+		},
+		{
+			name: "BankerFreezesStaff",
+			req: &pb.FreezeUserRequest{
+				Username: staff.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(staff.Username)).
+					Times(1).
+					Return(staff, nil)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "Self",
+			req: &pb.FreezeUserRequest{
+				Username: banker,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "SystemUser",
+			req: &pb.FreezeUserRequest{
+				Username: db.SystemUsername,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req: &pb.FreezeUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "DepositorDenied",
+			req: &pb.FreezeUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.FreezeUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					FreezeUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.FreezeUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetHold(ctx context.Context, req *pb.GetHoldRequest) (*pb.GetHoldResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	hold, err := server.store.GetHold(ctx, req.GetId())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "hold not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get hold: %s", err)
+	}
+
+	// staff may view any hold, others only the ones they placed or receive
+	if !util.IsStaffRole(authPayload.Role) && hold.Sender != authPayload.Username && hold.Recipient != authPayload.Username {
+		return nil, status.Errorf(codes.NotFound, "hold not found")
+	}
+
+	rsp := &pb.GetHoldResponse{
+		Hold: ConvertHold(hold),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,149 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetScheduledTransferAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	otherUser := randomUser(t, util.RandomString(6))
+	scheduledTransfer := randomScheduledTransfer(user.Username)
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetScheduledTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetScheduledTransferParams{
+					ID:    scheduledTransfer.ID,
+					Owner: user.Username,
+				}
+				store.EXPECT().
+					GetScheduledTransfer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(scheduledTransfer, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, scheduledTransfer.ID, res.GetScheduledTransfer().GetId())
+				require.Equal(t, scheduledTransfer.Amount, res.GetScheduledTransfer().GetAmount())
+				require.Equal(t, scheduledTransfer.Status, res.GetScheduledTransfer().GetStatus())
+			},
+		},
+		{
+			// schedules are looked up among the caller's own, so another user's schedule is not found
+			name: "ScheduleOfAnotherUser",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetScheduledTransferParams{
+					ID:    scheduledTransfer.ID,
+					Owner: otherUser.Username,
+				}
+				store.EXPECT().
+					GetScheduledTransfer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ScheduledTransfer{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetScheduledTransferResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ScheduledTransfer{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetScheduledTransferResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidID",
+			id:   0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetScheduledTransferResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			id:   scheduledTransfer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetScheduledTransferResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetScheduledTransfer(ctx, &pb.GetScheduledTransferRequest{Id: tc.id})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func randomScheduledTransfer(owner string) db.ScheduledTransfer {
+	fromAccountID := util.RandomInt(1, 1000)
+	return db.ScheduledTransfer{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         owner,
+		FromAccountID: fromAccountID,
+		ToAccountID:   fromAccountID + 1,
+		Amount:        util.RandomInt(1, 1000),
+		Frequency:     util.WeeklyFrequency,
+		NextRunAt:     time.Now().Add(24 * time.Hour),
+		Status:        db.ScheduledTransferStatusActive,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.AccountsReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, result, err := server.getStatement(ctx, authPayload, req.GetAccountId(), req.GetFromTime(), req.GetToTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertStatement(account, result), nil
+}
+
+// getStatement returns the statement of an account the user may see, with the same ownership check as GetAccount
+func (server *Server) getStatement(
+	ctx context.Context,
+	authPayload *token.Payload,
+	accountID int64,
+	fromTime *timestamppb.Timestamp,
+	toTime *timestamppb.Timestamp,
+) (db.Account, db.StatementTxResult, error) {
+	var result db.StatementTxResult
+
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return account, result, status.Errorf(codes.NotFound, "account not found")
+		}
+		return account, result, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+		return account, result, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	result, err = server.store.StatementTx(ctx, db.StatementTxParams{
+		AccountID: account.ID,
+		FromTime:  fromTime.AsTime(),
+		ToTime:    toTime.AsTime(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidStatementPeriod) {
+			return account, result, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		return account, result, status.Errorf(codes.Internal, "failed to get statement: %s", err)
+	}
+
+	return account, result, nil
+}
+
+func validateGetStatementRequest(req *pb.GetStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("accountId", err))
+	}
+	if err := req.GetFromTime().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("fromTime", err))
+	}
+	if err := req.GetToTime().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("toTime", err))
+	}
+	return violations
+}
@@ -0,0 +1,184 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetStatementAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account := randomAccount(user.Username)
+
+	toTime := time.Now().Truncate(time.Second)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	statement := db.StatementTxResult{
+		AccountID:      account.ID,
+		FromTime:       fromTime,
+		ToTime:         toTime,
+		OpeningBalance: 1000,
+		Lines: []db.StatementLine{
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					Entry: db.Entry{
+						ID:        util.RandomInt(1, 1000),
+						AccountID: account.ID,
+						Amount:    -300,
+					},
+					JournalKind:           db.JournalKindTransfer,
+					TransferID:            util.RandomInt(1, 1000),
+					CounterpartyAccountID: util.RandomInt(1, 1000),
+					Counterparty:          util.RandomOwner(),
+				},
+				Balance: 700,
+			},
+		},
+		ClosingBalance: 700,
+	}
+
+	validRequest := func() *pb.GetStatementRequest {
+		return &pb.GetStatementRequest{
+			AccountId: account.ID,
+			FromTime:  timestamppb.New(fromTime),
+			ToTime:    timestamppb.New(toTime),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetStatementResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				arg := db.StatementTxParams{
+					AccountID: account.ID,
+					FromTime:  fromTime.UTC(),
+					ToTime:    toTime.UTC(),
+				}
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(statement, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccountId())
+				require.Equal(t, account.Currency, res.GetCurrency())
+				require.Equal(t, statement.OpeningBalance, res.GetOpeningBalance())
+				require.Equal(t, statement.ClosingBalance, res.GetClosingBalance())
+				require.Len(t, res.GetLines(), 1)
+
+				line := res.GetLines()[0]
+				require.Equal(t, statement.Lines[0].Entry.ID, line.GetEntry().GetId())
+				require.Equal(t, statement.Lines[0].TransferID, line.GetTransferId())
+				require.Equal(t, statement.Lines[0].Counterparty, line.GetCounterparty())
+				require.Equal(t, statement.Lines[0].Balance, line.GetBalance())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "other", util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidPeriod",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					StatementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.StatementTxResult{}, db.ErrInvalidStatementPeriod)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MissingFromTime",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				ToTime:    timestamppb.New(toTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetStatement(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
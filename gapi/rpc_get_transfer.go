@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var transfer db.Transfer
+	if util.IsStaffRole(authPayload.Role) {
+		transfer, err = server.store.GetTransferById(ctx, req.GetId())
+	} else {
+		transfer, err = server.store.GetTransfer(ctx, db.GetTransferParams{
+			ID:       req.GetId(),
+			Username: authPayload.Username,
+		})
+	}
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	rsp := &pb.GetTransferResponse{
+		Transfer: ConvertTransfer(transfer),
+	}
+	return rsp, nil
+}
+
+func validateGetTransferRequest(req *pb.GetTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
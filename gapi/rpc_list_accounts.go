@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.AccountsReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// staff may list the accounts of any owner, everyone else only their own
+	owner := authPayload.Username
+	if req.Owner != nil {
+		if req.GetOwner() != authPayload.Username && !util.IsStaffRole(authPayload.Role) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot list other user's accounts")
+		}
+		owner = req.GetOwner()
+	}
+
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		ID:    req.GetPageId(),
+		Limit: req.GetPageSize(),
+		Owner: owner,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	rsp := &pb.ListAccountsResponse{
+		Accounts: make([]*pb.Account, len(accounts)),
+	}
+	for i, account := range accounts {
+		rsp.Accounts[i] = ConvertAccount(account)
+	}
+	return rsp, nil
+}
+
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageId(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("pageId", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("pageSize", err))
+	}
+	if req.Owner != nil {
+		if err := val.ValidateUsername(req.GetOwner()); err != nil {
+			violations = append(violations, fieldViolation("owner", err))
+		}
+	}
+	return violations
+}
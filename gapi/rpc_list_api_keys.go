@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/uwemakan/simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := server.store.ListApiKeys(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list API keys: %s", err)
+	}
+
+	rsp := &pb.ListApiKeysResponse{
+		ApiKeys: make([]*pb.ApiKey, len(apiKeys)),
+	}
+	for i, apiKey := range apiKeys {
+		rsp.ApiKeys[i] = ConvertApiKey(apiKey)
+	}
+	return rsp, nil
+}
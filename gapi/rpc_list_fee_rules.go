@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListFeeRules(ctx context.Context, req *pb.ListFeeRulesRequest) (*pb.ListFeeRulesResponse, error) {
+	_, err := server.authorizeUser(ctx, adminRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListFeeRulesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rules, err := server.store.ListFeeRules(ctx, db.ListFeeRulesParams{
+		ID:    req.GetPageId(),
+		Limit: req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fee rules: %s", err)
+	}
+
+	rsp := &pb.ListFeeRulesResponse{
+		FeeRules: make([]*pb.FeeRule, len(rules)),
+	}
+	for i, rule := range rules {
+		rsp.FeeRules[i] = ConvertFeeRule(rule)
+	}
+	return rsp, nil
+}
+
+func validateListFeeRulesRequest(req *pb.ListFeeRulesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageId(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("pageId", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("pageSize", err))
+	}
+	return violations
+}
@@ -0,0 +1,173 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListFeeRulesAPI(t *testing.T) {
+	admin := util.RandomOwner()
+
+	n := 5
+	rules := make([]db.FeeRule, n)
+	for i := range rules {
+		rules[i] = randomFeeRule()
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListFeeRulesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListFeeRulesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListFeeRulesRequest{
+				PageId:   0,
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListFeeRulesParams{
+					ID:    0,
+					Limit: int32(n),
+				}
+				store.EXPECT().
+					ListFeeRules(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rules, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFeeRulesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetFeeRules(), n)
+				for i, rule := range res.GetFeeRules() {
+					require.Equal(t, rules[i].ID, rule.GetId())
+					require.Equal(t, rules[i].Currency, rule.GetCurrency())
+					require.Equal(t, rules[i].FlatFee, rule.GetFlatFee())
+				}
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.ListFeeRulesRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFeeRules(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.FeeRule{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFeeRulesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			req: &pb.ListFeeRulesRequest{
+				PageSize: 100,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFeeRules(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin, util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFeeRulesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "BankerDenied",
+			req: &pb.ListFeeRulesRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFeeRules(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFeeRulesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.ListFeeRulesRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFeeRules(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFeeRulesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListFeeRules(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func randomFeeRule() db.FeeRule {
+	return db.FeeRule{
+		ID:           util.RandomInt(1, 1000),
+		Currency:     util.RandomCurrency(),
+		TransferType: db.TransferTypeInternal,
+		MinAmount:    util.RandomInt(0, 10000),
+		FlatFee:      util.RandomInt(0, 100),
+		BasisPoints:  util.RandomInt(0, 100),
+	}
+}
@@ -0,0 +1,129 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListScheduledTransfersAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	n := 5
+	scheduledTransfers := make([]db.ScheduledTransfer, n)
+	for i := 0; i < n; i++ {
+		scheduledTransfers[i] = randomScheduledTransfer(user.Username)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListScheduledTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListScheduledTransfersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListScheduledTransfersRequest{
+				PageId:   0,
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListScheduledTransfersParams{
+					ID:    0,
+					Owner: user.Username,
+					Limit: int32(n),
+				}
+				store.EXPECT().
+					ListScheduledTransfers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(scheduledTransfers, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScheduledTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetScheduledTransfers(), n)
+				for i, scheduledTransfer := range scheduledTransfers {
+					require.Equal(t, scheduledTransfer.ID, res.GetScheduledTransfers()[i].GetId())
+					require.Equal(t, user.Username, res.GetScheduledTransfers()[i].GetOwner())
+				}
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.ListScheduledTransfersRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListScheduledTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ScheduledTransfer{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScheduledTransfersResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			req: &pb.ListScheduledTransfersRequest{
+				PageSize: 100,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListScheduledTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScheduledTransfersResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.ListScheduledTransfersRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListScheduledTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScheduledTransfersResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListScheduledTransfers(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
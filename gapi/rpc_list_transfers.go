@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var transfers []db.Transfer
+	switch {
+	case req.FromAccountId != nil && req.ToAccountId != nil:
+		transfers, err = server.store.ListTransfersByFromAndToAccount(ctx, db.ListTransfersByFromAndToAccountParams{
+			FromAccountID: req.GetFromAccountId(),
+			ToAccountID:   req.GetToAccountId(),
+			ID:            req.GetPageId(),
+			Sender:        authPayload.Username,
+			Limit:         req.GetPageSize(),
+		})
+	case req.FromAccountId != nil:
+		transfers, err = server.store.ListTransfersByFromAccount(ctx, db.ListTransfersByFromAccountParams{
+			FromAccountID: req.GetFromAccountId(),
+			ID:            req.GetPageId(),
+			Sender:        authPayload.Username,
+			Limit:         req.GetPageSize(),
+		})
+	case req.ToAccountId != nil:
+		transfers, err = server.store.ListTransfersByToAccount(ctx, db.ListTransfersByToAccountParams{
+			ToAccountID: req.GetToAccountId(),
+			ID:          req.GetPageId(),
+			Sender:      authPayload.Username,
+			Limit:       req.GetPageSize(),
+		})
+	default:
+		transfers, err = server.store.ListTransfers(ctx, db.ListTransfersParams{
+			ID:     req.GetPageId(),
+			Sender: authPayload.Username,
+			Limit:  req.GetPageSize(),
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	rsp := &pb.ListTransfersResponse{
+		Transfers: make([]*pb.Transfer, len(transfers)),
+	}
+	for i, transfer := range transfers {
+		rsp.Transfers[i] = ConvertTransfer(transfer)
+	}
+	return rsp, nil
+}
+
+func validateListTransfersRequest(req *pb.ListTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageId(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("pageId", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("pageSize", err))
+	}
+	if req.FromAccountId != nil {
+		if err := val.ValidateId(req.GetFromAccountId()); err != nil {
+			violations = append(violations, fieldViolation("fromAccountId", err))
+		}
+	}
+	if req.ToAccountId != nil {
+		if err := val.ValidateId(req.GetToAccountId()); err != nil {
+			violations = append(violations, fieldViolation("toAccountId", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,179 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListTransfersAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	fromAccountID := util.RandomInt(1, 1000)
+	toAccountID := util.RandomInt(1, 1000)
+
+	n := 5
+	transfers := make([]db.Transfer, n)
+	for i := 0; i < n; i++ {
+		transfers[i] = randomTransfer(user.Username, util.RandomOwner())
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListTransfersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ListTransfersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListTransfersRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTransfersParams{
+					ID:     0,
+					Sender: user.Username,
+					Limit:  int32(n),
+				}
+				store.EXPECT().
+					ListTransfers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+				for i, transfer := range res.GetTransfers() {
+					require.Equal(t, transfers[i].ID, transfer.GetId())
+				}
+			},
+		},
+		{
+			name: "FromAccount",
+			req: &pb.ListTransfersRequest{
+				PageSize:      int32(n),
+				FromAccountId: &fromAccountID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTransfersByFromAccountParams{
+					FromAccountID: fromAccountID,
+					ID:            0,
+					Sender:        user.Username,
+					Limit:         int32(n),
+				}
+				store.EXPECT().
+					ListTransfersByFromAccount(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+			},
+		},
+		{
+			name: "ToAccount",
+			req: &pb.ListTransfersRequest{
+				PageSize:    int32(n),
+				ToAccountId: &toAccountID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTransfersByToAccountParams{
+					ToAccountID: toAccountID,
+					ID:          0,
+					Sender:      user.Username,
+					Limit:       int32(n),
+				}
+				store.EXPECT().
+					ListTransfersByToAccount(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+			},
+		},
+		{
+			name: "FromAndToAccount",
+			req: &pb.ListTransfersRequest{
+				PageSize:      int32(n),
+				FromAccountId: &fromAccountID,
+				ToAccountId:   &toAccountID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTransfersByFromAndToAccountParams{
+					FromAccountID: fromAccountID,
+					ToAccountID:   toAccountID,
+					ID:            0,
+					Sender:        user.Username,
+					Limit:         int32(n),
+				}
+				store.EXPECT().
+					ListTransfersByFromAndToAccount(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTransfers(), n)
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			req: &pb.ListTransfersRequest{
+				PageSize: 100,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.ListTransfersRequest{
+				PageSize: int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Transfer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTransfersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			res, err := server.ListTransfers(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -3,18 +3,24 @@ package gapi
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/bufbuild/protovalidate-go"
 	db "github.com/uwemakan/simplebank/db/sqlc"
 	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
 	"github.com/uwemakan/simplebank/val"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// mfaChallengeDuration is how long a user with two-factor authentication has to send their code after the password
+const mfaChallengeDuration = 5 * time.Minute
+
 func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
 	// violations := validateLoginUserRequest(req)
 	// if violations != nil {
@@ -31,10 +37,22 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "invalid username/password: %s", err)
 	}
 
+	m := server.extractMetadata(ctx)
+	guard, err := server.guardLogin(ctx, req.GetUsername(), m.ClientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := guard.delay(ctx); err != nil {
+		return nil, err
+	}
+
+	// unknown users and wrong passwords get the same response, so that usernames can't be probed
 	user, err := server.store.GetUser(ctx, req.GetUsername())
 	if err != nil {
 		if err == db.ErrRecordNotFound {
-			return nil, status.Errorf(codes.NotFound, "invalid username/password: %s", err)
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.GetPassword()))
+			return nil, guard.reject(ctx, unauthenticatedError(errInvalidCredentials))
 		}
 		return nil, status.Errorf(codes.Internal, "user login failed: %s", err)
 	}
@@ -45,12 +63,51 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "invalid username/password: %s", err)
+		return nil, guard.reject(ctx, unauthenticatedError(errInvalidCredentials))
+	}
+
+	// a frozen user gets the same response as a wrong password, so that it doesn't tell whether the password was right
+	if user.IsFrozen {
+		return nil, guard.reject(ctx, unauthenticatedError(errInvalidCredentials))
+	}
+
+	credential, err := server.store.GetTotpCredential(ctx, user.Username)
+	if err != nil && err != db.ErrRecordNotFound {
+		return nil, status.Errorf(codes.Internal, "user login failed: %s", err)
+	}
+	if err == nil && credential.IsEnabled {
+		challengeToken, challengePayload, err := server.tokenMaker.CreateToken(
+			user.Username,
+			user.Role,
+			mfaChallengeDuration,
+			token.TokenTypeMfaChallenge,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create mfa challenge token: %s", err)
+		}
+
+		rsp := &pb.LoginUserResponse{
+			MfaRequired:           true,
+			MfaChallengeToken:     challengeToken,
+			MfaChallengeExpiresAt: timestamppb.New(challengePayload.ExpiredAt),
+		}
+		return rsp, nil
 	}
 
+	if err := guard.succeed(ctx); err != nil {
+		return nil, err
+	}
+
+	return server.createLoginSession(ctx, user)
+}
+
+// createLoginSession issues the access and refresh tokens of a new session once the user is fully authenticated
+func (server *Server) createLoginSession(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.AccessTokenDuration,
+		token.TokenTypeAccessToken,
 	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access token: %s", err)
@@ -58,34 +115,36 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.RefreshTokenDuration,
+		token.TokenTypeRefreshToken,
 	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create refresh token: %s", err)
 	}
 	m := server.extractMetadata(ctx)
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
-		ID:           refreshPayload.ID,
-		Username:     user.Username,
-		RefreshToken: refreshToken,
-		UserAgent:    m.UserAgent,
-		ClientIp:     m.ClientIP,
-		IsBlocked:    false,
-		ExpiresAt:    refreshPayload.ExpiredAt,
+		ID:                   refreshPayload.ID,
+		FamilyID:             refreshPayload.ID,
+		Username:             user.Username,
+		RefreshToken:         refreshToken,
+		UserAgent:            m.UserAgent,
+		ClientIp:             m.ClientIP,
+		IsBlocked:            false,
+		ExpiresAt:            refreshPayload.ExpiredAt,
+		AccessTokenID:        accessPayload.ID,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
 	})
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create session: %s", err)
 	}
 
-	rsp := &pb.LoginUserResponse{
-		SessionId:             session.ID.String(),
-		AccessToken:           accessToken,
-		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
-		RefreshToken:          refreshToken,
-		RefreshTokenExpiresAt: timestamppb.New(refreshPayload.ExpiredAt),
-		User:                  ConvertUser(user),
-	}
+	rsp := ConvertLoginResponse(
+		user, session,
+		accessToken, refreshToken,
+		accessPayload.ExpiredAt, refreshPayload.ExpiredAt,
+	)
 	return rsp, nil
 }
 
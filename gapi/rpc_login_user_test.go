@@ -0,0 +1,375 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/worker"
+	mockwk "github.com/uwemakan/simplebank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testLoginLockoutThreshold   = 5
+	testLoginIPLockoutThreshold = 20
+)
+
+// expectLoginGuard stubs the lockout checks done before a login attempt
+func expectLoginGuard(t *testing.T, store *mockdb.MockStore, username string, failures int64) {
+	store.EXPECT().GetLatestLoginLockout(gomock.Any(), gomock.Any()).Times(2).Return(db.LoginLockout{}, db.ErrRecordNotFound)
+	store.EXPECT().
+		CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, arg db.CountLoginFailuresByUsernameParams) (int64, error) {
+			require.Equal(t, username, arg.Username)
+			return failures, nil
+		})
+}
+
+// expectLoginAttempt stubs the recording of a login attempt
+func expectLoginAttempt(t *testing.T, store *mockdb.MockStore, username string, isSuccessful bool) {
+	store.EXPECT().
+		CreateLoginAttempt(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+			require.Equal(t, username, arg.Username)
+			require.Equal(t, isSuccessful, arg.IsSuccessful)
+			return db.LoginAttempt{Username: arg.Username, ClientIp: arg.ClientIp, IsSuccessful: arg.IsSuccessful}, nil
+		})
+}
+
+// expectLoginFailure stubs the recording of a failed login that does not reach any lockout threshold
+func expectLoginFailure(t *testing.T, store *mockdb.MockStore, username string) {
+	expectLoginAttempt(t, store, username, false)
+	store.EXPECT().CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+	store.EXPECT().CountLoginFailuresByClientIp(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+	store.EXPECT().CreateLoginLockout(gomock.Any(), gomock.Any()).Times(0)
+}
+
+func TestLoginUserAPI(t *testing.T) {
+	password := util.RandomString(6)
+	user := randomUser(t, password)
+
+	testCases := []struct {
+		name          string
+		username      string
+		password      string
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				expectLoginAttempt(t, store, user.Username, true)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name:     "UserNotFound",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+				require.Equal(t, unauthenticatedError(errInvalidCredentials).Error(), err.Error())
+			},
+		},
+		{
+			name:     "WrongPassword",
+			username: user.Username,
+			password: util.RandomString(8),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+				// same response as for an unknown user, without the bcrypt error
+				require.Equal(t, unauthenticatedError(errInvalidCredentials).Error(), err.Error())
+			},
+		},
+		{
+			name:     "FrozenUser",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				frozenUser := user
+				frozenUser.IsFrozen = true
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(frozenUser, nil)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+				require.Equal(t, unauthenticatedError(errInvalidCredentials).Error(), err.Error())
+			},
+		},
+		{
+			name:     "UsernameLockedOut",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				lockout := db.LoginLockout{
+					SubjectType: lockoutSubjectUsername,
+					Subject:     user.Username,
+					LockedUntil: time.Now().Add(time.Minute),
+				}
+				store.EXPECT().
+					GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+						SubjectType: lockoutSubjectUsername,
+						Subject:     user.Username,
+					})).
+					Times(1).
+					Return(lockout, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateLoginAttempt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			name:     "ExpiredLockout",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				lockedUntil := time.Now().Add(-time.Minute)
+				store.EXPECT().
+					GetLatestLoginLockout(gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(db.LoginLockout{LockedUntil: lockedUntil}, nil)
+				store.EXPECT().
+					CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CountLoginFailuresByUsernameParams) (int64, error) {
+						// failures before the end of the lockout no longer count
+						require.Equal(t, lockedUntil, arg.Since)
+						return 0, nil
+					})
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				expectLoginAttempt(t, store, user.Username, true)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "UsernameThresholdReached",
+			username: user.Username,
+			password: util.RandomString(8),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(2).Return(user, nil)
+				expectLoginAttempt(t, store, user.Username, false)
+				store.EXPECT().CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).Times(1).Return(int64(testLoginLockoutThreshold), nil)
+				store.EXPECT().
+					CreateLoginLockout(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateLoginLockoutParams) (db.LoginLockout, error) {
+						require.Equal(t, lockoutSubjectUsername, arg.SubjectType)
+						require.Equal(t, user.Username, arg.Subject)
+						require.Equal(t, int64(testLoginLockoutThreshold), arg.FailedAttempts)
+						require.True(t, arg.LockedUntil.After(time.Now()))
+						return db.LoginLockout{SubjectType: arg.SubjectType, Subject: arg.Subject, FailedAttempts: arg.FailedAttempts, LockedUntil: arg.LockedUntil}, nil
+					})
+				taskDistributor.EXPECT().
+					DistributeTaskSendLockoutNotification(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, payload *worker.PayloadSendLockoutNotification, _ ...any) error {
+						require.Equal(t, user.Username, payload.Username)
+						require.Equal(t, int64(testLoginLockoutThreshold), payload.FailedAttempts)
+						return nil
+					})
+				store.EXPECT().CountLoginFailuresByClientIp(gomock.Any(), gomock.Any()).Times(1).Return(int64(testLoginLockoutThreshold), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:     "ClientIPThresholdReached",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				expectLoginAttempt(t, store, user.Username, false)
+				store.EXPECT().CountLoginFailuresByUsername(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+				store.EXPECT().CountLoginFailuresByClientIp(gomock.Any(), gomock.Any()).Times(1).Return(int64(testLoginIPLockoutThreshold), nil)
+				store.EXPECT().
+					CreateLoginLockout(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateLoginLockoutParams) (db.LoginLockout, error) {
+						require.Equal(t, lockoutSubjectClientIP, arg.SubjectType)
+						return db.LoginLockout{}, nil
+					})
+				taskDistributor.EXPECT().DistributeTaskSendLockoutNotification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:     "InternalError",
+			username: user.Username,
+			password: password,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetLatestLoginLockout(gomock.Any(), gomock.Any()).Times(1).Return(db.LoginLockout{}, sql.ErrConnDone)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			server := newTestServer(t, store, taskDistributor)
+			server.config.LoginFailureWindow = 15 * time.Minute
+			server.config.LoginLockoutThreshold = testLoginLockoutThreshold
+			server.config.LoginIPLockoutThreshold = testLoginIPLockoutThreshold
+			server.config.LoginLockoutDuration = 15 * time.Minute
+
+			res, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+				Username: tc.username,
+				Password: tc.password,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestLoginUserDelay(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+
+	store := mockdb.NewMockStore(storeCtrl)
+	expectLoginGuard(t, store, user.Username, 3)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.config.LoginDelayBase = time.Hour
+
+	// a client giving up while being delayed doesn't get to try the password
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := server.LoginUser(ctx, &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: util.RandomString(8),
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.DeadlineExceeded, st.Code())
+}
+
+func TestLoginUserSpoofedForwardedFor(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	remoteIP := "198.51.100.1"
+
+	// the gateway appends the address the request came from to whatever the client sent
+	for _, forwardedFor := range []string{
+		remoteIP,
+		"203.0.113.7, " + remoteIP,
+		"203.0.113.8, 10.0.0.1, " + remoteIP,
+	} {
+		storeCtrl := gomock.NewController(t)
+		store := mockdb.NewMockStore(storeCtrl)
+
+		store.EXPECT().
+			GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+				SubjectType: lockoutSubjectUsername,
+				Subject:     user.Username,
+			})).
+			Times(1).
+			Return(db.LoginLockout{}, db.ErrRecordNotFound)
+		store.EXPECT().
+			GetLatestLoginLockout(gomock.Any(), gomock.Eq(db.GetLatestLoginLockoutParams{
+				SubjectType: lockoutSubjectClientIP,
+				Subject:     remoteIP,
+			})).
+			Times(1).
+			Return(db.LoginLockout{LockedUntil: time.Now().Add(time.Minute)}, nil)
+		store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+
+		server := newTestServer(t, store, nil)
+
+		// a different forwarded client on every attempt doesn't get around the lockout of the address
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(xForwardedForHeader, forwardedFor))
+		_, err := server.LoginUser(ctx, &pb.LoginUserRequest{
+			Username: user.Username,
+			Password: util.RandomString(8),
+		})
+		require.Error(t, err, forwardedFor)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.ResourceExhausted, st.Code(), forwardedFor)
+
+		storeCtrl.Finish()
+	}
+}
@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/util"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.QuoteTransferResponse, error) {
+	authPayload, err := server.authorizeClient(ctx, allRoles, util.TransfersReadScope)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateQuoteTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	// scheduled transfers are never converted, other transfers may go to an account of another currency
+	toCurrency := ""
+	if req.GetScheduled() {
+		toCurrency = req.GetCurrency()
+	}
+
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), toCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.QuoteTransferResponse{
+		TransferType: db.TransferType(fromAccount.Currency, toAccount.Currency, req.GetScheduled()),
+		Amount:       req.GetAmount(),
+		Currency:     fromAccount.Currency,
+		ToAmount:     req.GetAmount(),
+		ToCurrency:   toAccount.Currency,
+		ExchangeRate: "1",
+	}
+
+	if toAccount.Currency != fromAccount.Currency {
+		conversion, err := fx.Convert(ctx, server.rateProvider, req.GetAmount(), fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) {
+				return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to convert amount: %s", err)
+		}
+
+		rsp.ToAmount = conversion.ToAmount
+		rsp.ExchangeRate = conversion.RateString()
+	}
+
+	rsp.Fee, err = db.TransferFee(ctx, server.store, db.TransferFeeParams{
+		Currency:     fromAccount.Currency,
+		TransferType: rsp.TransferType,
+		Amount:       req.GetAmount(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer fee: %s", err)
+	}
+	rsp.TotalAmount = rsp.Amount + rsp.Fee
+
+	return rsp, nil
+}
+
+func validateQuoteTransferRequest(req *pb.QuoteTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("fromAccountId", err))
+	}
+	if err := val.ValidateId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("toAccountId", err))
+	}
+	if req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("toAccountId", fmt.Errorf("must differ from fromAccountId")))
+	}
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+	return violations
+}
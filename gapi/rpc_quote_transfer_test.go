@@ -0,0 +1,194 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQuoteTransferAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	account1 := randomAccount(user.Username)
+	account1.Currency = util.USD
+	account2 := randomAccount(util.RandomOwner())
+	account2.ID = account1.ID + 1
+	account2.Currency = util.USD
+	account3 := randomAccount(util.RandomOwner())
+	account3.ID = account1.ID + 2
+	account3.Currency = util.EUR
+
+	amount := int64(1000)
+	rule := db.FeeRule{
+		Currency:     util.USD,
+		TransferType: db.TransferTypeInternal,
+		FlatFee:      5,
+		BasisPoints:  100,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.QuoteTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.QuoteTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.QuoteTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				arg := db.GetFeeRuleParams{
+					Currency:     util.USD,
+					TransferType: db.TransferTypeInternal,
+					Amount:       amount,
+				}
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rule, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.QuoteTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.TransferTypeInternal, res.GetTransferType())
+				require.Equal(t, amount, res.GetAmount())
+				require.Equal(t, int64(15), res.GetFee())
+				require.Equal(t, amount+15, res.GetTotalAmount())
+				require.Equal(t, amount, res.GetToAmount())
+				require.Equal(t, util.USD, res.GetToCurrency())
+			},
+		},
+		{
+			name: "CrossCurrencyWithoutFeeRule",
+			req: &pb.QuoteTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account3.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
+				arg := db.GetFeeRuleParams{
+					Currency:     util.USD,
+					TransferType: db.TransferTypeCrossCurrency,
+					Amount:       amount,
+				}
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.FeeRule{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.QuoteTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.TransferTypeCrossCurrency, res.GetTransferType())
+				require.Zero(t, res.GetFee())
+				require.Equal(t, amount, res.GetTotalAmount())
+				require.Equal(t, int64(920), res.GetToAmount())
+				require.Equal(t, util.EUR, res.GetToCurrency())
+				require.Equal(t, "0.9200000000", res.GetExchangeRate())
+			},
+		},
+		{
+			name: "ScheduledCurrencyMismatch",
+			req: &pb.QuoteTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account3.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Scheduled:     true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.QuoteTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.QuoteTransferRequest{
+				FromAccountId: account2.ID,
+				ToAccountId:   account1.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.QuoteTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req: &pb.QuoteTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        -1,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.QuoteTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			rateProvider, err := fx.NewStaticRateProvider(map[string]string{"USD/EUR": "0.92"})
+			require.NoError(t, err)
+			server.rateProvider = rateProvider
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.QuoteTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+)
+
+func (server *Server) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	result, err := server.changeAccountStatus(ctx, req.GetId(), req.GetReason(), db.AccountStatusClosed, db.AccountStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ReopenAccountResponse{
+		Account:      ConvertAccount(result.Account),
+		StatusChange: ConvertAccountStatusChange(result.StatusChange),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,191 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReverseTransferAPI(t *testing.T) {
+	sender := randomUser(t, util.RandomString(6))
+	recipient := randomUser(t, util.RandomString(6))
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1, 1000),
+		Sender:        sender.Username,
+		Recipient:     recipient.Username,
+		Amount:        1000,
+		ToAmount:      1000,
+		Status:        db.TransferStatusOriginal,
+	}
+
+	reversed := transfer
+	reversed.Status = db.TransferStatusReversed
+	reversed.RefundedAmount = transfer.ToAmount
+
+	result := db.ReverseTransferTxResult{
+		TransferTxResult: db.TransferTxResult{
+			Transfer: db.Transfer{
+				ID:            transfer.ID + 1,
+				FromAccountID: transfer.ToAccountID,
+				ToAccountID:   transfer.FromAccountID,
+				Sender:        recipient.Username,
+				Recipient:     sender.Username,
+				Amount:        transfer.ToAmount,
+				ToAmount:      transfer.Amount,
+				Status:        db.TransferStatusOriginal,
+			},
+		},
+		OriginalTransfer: reversed,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReverseTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReverseTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ReverseTransferRequest{
+				TransferId: transfer.ID,
+				Reason:     "wrong recipient",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferById(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+
+				arg := db.ReverseTransferTxParams{
+					TransferID: transfer.ID,
+					Reason:     "wrong recipient",
+					ReversedBy: recipient.Username,
+				}
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.Transfer.ID, res.GetTransfer().GetId())
+				require.Equal(t, transfer.FromAccountID, res.GetTransfer().GetToAccountId())
+				require.Equal(t, db.TransferStatusReversed, res.GetOriginalTransfer().GetStatus())
+				require.Equal(t, transfer.ToAmount, res.GetOriginalTransfer().GetRefundedAmount())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.ReverseTransferRequest{
+				TransferId: transfer.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferById(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "RefundExceedsTransfer",
+			req: &pb.ReverseTransferRequest{
+				TransferId: transfer.ID,
+				Amount:     func() *int64 { amount := transfer.ToAmount + 1; return &amount }(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferById(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, db.ErrRefundExceedsTransfer)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "banker", util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "RefundTooSmall",
+			req: &pb.ReverseTransferRequest{
+				TransferId: transfer.ID,
+				Amount:     func() *int64 { amount := int64(1); return &amount }(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferById(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, db.ErrRefundTooSmall)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req: &pb.ReverseTransferRequest{
+				TransferId: transfer.ID,
+				Amount:     func() *int64 { amount := int64(0); return &amount }(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferById(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ReverseTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyID, err := uuid.Parse(req.GetApiKeyId())
+	if err != nil {
+		violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("apiKeyId", err)}
+		return nil, invalidArgumentError(violations)
+	}
+
+	apiKey, err := server.store.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ID:       apiKeyID,
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "API key not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key: %s", err)
+	}
+
+	rsp := &pb.RevokeApiKeyResponse{
+		ApiKey: ConvertApiKey(apiKey),
+	}
+	return rsp, nil
+}
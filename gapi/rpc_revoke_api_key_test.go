@@ -0,0 +1,135 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeApiKeyAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	otherUser := randomUser(t, util.RandomString(6))
+	apiKey, _ := randomAPIKey(t, user.Username, util.AccountsReadScope)
+
+	testCases := []struct {
+		name          string
+		req           *pb.RevokeApiKeyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RevokeApiKeyResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.RevokeApiKeyRequest{
+				ApiKeyId: apiKey.ID.String(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.RevokeApiKeyParams{
+					ID:       apiKey.ID,
+					Username: user.Username,
+				}
+				revoked := apiKey
+				revoked.IsRevoked = true
+				store.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(revoked, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeApiKeyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, apiKey.ID.String(), res.GetApiKey().GetId())
+				require.True(t, res.GetApiKey().GetIsRevoked())
+			},
+		},
+		{
+			// the key is looked up among the caller's own, so another user's key is not found
+			name: "ApiKeyOfAnotherUser",
+			req: &pb.RevokeApiKeyRequest{
+				ApiKeyId: apiKey.ID.String(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.RevokeApiKeyParams{
+					ID:       apiKey.ID,
+					Username: otherUser.Username,
+				}
+				store.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ApiKey{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeApiKeyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidApiKeyID",
+			req: &pb.RevokeApiKeyRequest{
+				ApiKeyId: "invalid-id",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RevokeApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeApiKeyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.RevokeApiKeyRequest{
+				ApiKeyId: apiKey.ID.String(),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RevokeApiKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeApiKeyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.RevokeApiKey(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RevokeOtherSessions(ctx context.Context, req *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, allRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeOtherSessionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, err := server.currentSession(ctx, authPayload, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "blocked session")
+	}
+
+	result, err := server.store.BlockOtherSessionsTx(ctx, db.BlockOtherSessionsParams{
+		Username: authPayload.Username,
+		FamilyID: session.FamilyID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %s", err)
+	}
+	server.cacheRevokedTokens(result.RevokedTokens)
+
+	rsp := &pb.RevokeOtherSessionsResponse{
+		RevokedCount: result.BlockedCount,
+	}
+	return rsp, nil
+}
+
+func validateRevokeOtherSessionsRequest(req *pb.RevokeOtherSessionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetRefreshToken(), 1, 1000); err != nil {
+		violations = append(violations, fieldViolation("refreshToken", err))
+	}
+	return violations
+}
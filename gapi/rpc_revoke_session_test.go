@@ -0,0 +1,134 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeSessionAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	otherUser := randomUser(t, util.RandomString(6))
+
+	testCases := []struct {
+		name          string
+		sessionID     func(session db.Session) string
+		buildStubs    func(store *mockdb.MockStore, session db.Session)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RevokeSessionResponse, session db.Session, err error)
+	}{
+		{
+			name: "OK",
+			sessionID: func(session db.Session) string {
+				return session.ID.String()
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				arg := db.BlockSessionParams{
+					ID:       session.ID,
+					Username: user.Username,
+				}
+				session.IsBlocked = true
+				store.EXPECT().
+					BlockSessionTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BlockSessionTxResult{Session: session}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeSessionResponse, session db.Session, err error) {
+				require.NoError(t, err)
+				require.Equal(t, session.ID.String(), res.GetSession().GetId())
+			},
+		},
+		{
+			// the session is looked up among the caller's own, so another user's session is not found
+			name: "SessionOfAnotherUser",
+			sessionID: func(session db.Session) string {
+				return session.ID.String()
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				arg := db.BlockSessionParams{
+					ID:       session.ID,
+					Username: otherUser.Username,
+				}
+				store.EXPECT().
+					BlockSessionTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BlockSessionTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeSessionResponse, session db.Session, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidSessionID",
+			sessionID: func(session db.Session) string {
+				return "invalid-id"
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().BlockSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeSessionResponse, session db.Session, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			sessionID: func(session db.Session) string {
+				return session.ID.String()
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().BlockSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.RevokeSessionResponse, session db.Session, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+
+			store := mockdb.NewMockStore(storeCtrl)
+			server := newTestServer(t, store, nil)
+
+			session, _ := randomSession(t, server.tokenMaker, user.Username)
+			tc.buildStubs(store, session)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.RevokeSession(ctx, &pb.RevokeSessionRequest{SessionId: tc.sessionID(session)})
+			tc.checkResponse(t, res, session, err)
+		})
+	}
+}
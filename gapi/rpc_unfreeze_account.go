@@ -0,0 +1,21 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+)
+
+func (server *Server) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	result, err := server.changeAccountStatus(ctx, req.GetId(), req.GetReason(), db.AccountStatusFrozen, db.AccountStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UnfreezeAccountResponse{
+		Account:      ConvertAccount(result.Account),
+		StatusChange: ConvertAccountStatusChange(result.StatusChange),
+	}
+	return rsp, nil
+}
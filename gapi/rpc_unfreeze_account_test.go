@@ -0,0 +1,181 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnfreezeAccountAPI(t *testing.T) {
+	banker := randomUser(t, util.RandomString(6))
+	account := randomAccount(util.RandomOwner())
+	account.Status = db.AccountStatusFrozen
+
+	updatedAccount := account
+	updatedAccount.Status = db.AccountStatusActive
+	result := db.UpdateAccountStatusTxResult{
+		Account: updatedAccount,
+		StatusChange: db.AccountStatusChange{
+			ID:         util.RandomInt(1, 1000),
+			AccountID:  account.ID,
+			FromStatus: db.AccountStatusFrozen,
+			ToStatus:   db.AccountStatusActive,
+			Reason:     "investigation closed",
+			ChangedBy:  banker.Username,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.UnfreezeAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UnfreezeAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.UnfreezeAccountRequest{
+				Id:     account.ID,
+				Reason: "investigation closed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID:  account.ID,
+					FromStatus: db.AccountStatusFrozen,
+					ToStatus:   db.AccountStatusActive,
+					Reason:     "investigation closed",
+					ChangedBy:  banker.Username,
+				}
+				store.EXPECT().
+					UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.AccountStatusActive, res.GetAccount().GetStatus())
+				require.Equal(t, db.AccountStatusFrozen, res.GetStatusChange().GetFromStatus())
+				require.Equal(t, "investigation closed", res.GetStatusChange().GetReason())
+				require.Equal(t, banker.Username, res.GetStatusChange().GetChangedBy())
+			},
+		},
+		{
+			// only a frozen account can be unfrozen
+			name: "NotFrozen",
+			req: &pb.UnfreezeAccountRequest{
+				Id:     account.ID,
+				Reason: "investigation closed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the transaction only moves the account out of the expected status
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID:  account.ID,
+					FromStatus: db.AccountStatusFrozen,
+					ToStatus:   db.AccountStatusActive,
+					Reason:     "investigation closed",
+					ChangedBy:  banker.Username,
+				}
+				store.EXPECT().
+					UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrAccountStatusChange)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NotFound",
+			req: &pb.UnfreezeAccountRequest{
+				Id:     account.ID,
+				Reason: "investigation closed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateAccountStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "MissingReason",
+			req: &pb.UnfreezeAccountRequest{
+				Id: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "Depositor",
+			req: &pb.UnfreezeAccountRequest{
+				Id:     account.ID,
+				Reason: "investigation closed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, util.DepositorRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UnfreezeAccount(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UnfreezeUser(ctx context.Context, req *pb.UnfreezeUserRequest) (*pb.UnfreezeUserResponse, error) {
+	if err := server.authorizeUserFreeze(ctx, req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	// the sessions blocked by the freeze stay blocked, so the user logs in again
+	user, err := server.store.UpdateUserFrozen(ctx, db.UpdateUserFrozenParams{
+		Username: req.GetUsername(),
+		IsFrozen: false,
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unfreeze user: %s", err)
+	}
+
+	rsp := &pb.UnfreezeUserResponse{
+		User: ConvertUser(user),
+	}
+	return rsp, nil
+}
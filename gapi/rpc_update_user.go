@@ -15,9 +15,9 @@ import (
 )
 
 func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx)
+	authPayload, err := server.authorizeUser(ctx, allRoles)
 	if err != nil {
-		return nil, unauthenticatedError(err)
+		return nil, err
 	}
 
 	violations := validateUpdateUserRequest(req)
@@ -25,10 +25,15 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, invalidArgumentError(violations)
 	}
 
-	if authPayload.Username != req.GetUsername() {
+	if authPayload.Username != req.GetUsername() && authPayload.Role != util.AdminRole {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
 	}
 
+	// the system user has no password so that nobody can log in as the owner of the system accounts
+	if req.GetUsername() == db.SystemUsername {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrSystemUser)
+	}
+
 	arg := db.UpdateUserParams{
 		Username: req.GetUsername(),
 		FullName: pgtype.Text{
@@ -64,6 +69,10 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
 
+	if req.Password != nil {
+		server.passwordChecker.Forget(user.Username)
+	}
+
 	rsp := &pb.UpdateUserResponse{
 		User: ConvertUser(user),
 	}
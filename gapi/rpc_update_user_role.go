@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, adminRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateUserRoleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// keeps the last admin from locking everyone out of role management
+	if req.GetUsername() == authPayload.Username {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change own role")
+	}
+
+	if req.GetUsername() == db.SystemUsername {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrSystemUser)
+	}
+
+	user, err := server.store.UpdateUserRoleTx(ctx, db.UpdateUserRoleParams{
+		Username: req.GetUsername(),
+		Role:     req.GetRole(),
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update user role: %s", err)
+	}
+
+	rsp := &pb.UpdateUserRoleResponse{
+		User: ConvertUser(user),
+	}
+	return rsp, nil
+}
+
+func validateUpdateUserRoleRequest(req *pb.UpdateUserRoleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+	if err := val.ValidateRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+	return violations
+}
@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/totp"
+	"github.com/uwemakan/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidAuthenticationCode = errors.New("invalid authentication code")
+
+func (server *Server) VerifyLoginMfa(ctx context.Context, req *pb.VerifyLoginMfaRequest) (*pb.LoginUserResponse, error) {
+	violations := validateVerifyLoginMfaRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	challengePayload, err := server.tokenMaker.VerifyToken(req.GetMfaChallengeToken(), token.TokenTypeMfaChallenge)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.passwordChecker.Check(ctx, challengePayload); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	// guessing codes counts towards the same lockout as guessing passwords
+	m := server.extractMetadata(ctx)
+	guard, err := server.guardLogin(ctx, challengePayload.Username, m.ClientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := guard.delay(ctx); err != nil {
+		return nil, err
+	}
+
+	credential, err := server.store.GetTotpCredential(ctx, challengePayload.Username)
+	if err != nil && err != db.ErrRecordNotFound {
+		return nil, status.Errorf(codes.Internal, "failed to get totp credential: %s", err)
+	}
+	if err != nil || !credential.IsEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	if req.TotpCode != nil {
+		counter, ok := totp.Validate(credential.Secret, req.GetTotpCode(), time.Now())
+		if !ok {
+			return nil, guard.reject(ctx, unauthenticatedError(errInvalidAuthenticationCode))
+		}
+
+		// fails if a code of this or a later time step was already used
+		_, err = server.store.UpdateTotpLastUsedCounter(ctx, db.UpdateTotpLastUsedCounterParams{
+			Username:        credential.Username,
+			LastUsedCounter: counter,
+		})
+	} else {
+		_, err = server.store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+			Username:   credential.Username,
+			HashedCode: totp.HashRecoveryCode(req.GetRecoveryCode()),
+		})
+	}
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, guard.reject(ctx, unauthenticatedError(errInvalidAuthenticationCode))
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify authentication code: %s", err)
+	}
+
+	user, err := server.store.GetUser(ctx, credential.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	// the user may have been frozen since the password step
+	if user.IsFrozen {
+		return nil, guard.reject(ctx, unauthenticatedError(errInvalidAuthenticationCode))
+	}
+
+	if err := guard.succeed(ctx); err != nil {
+		return nil, err
+	}
+
+	return server.createLoginSession(ctx, user)
+}
+
+func validateVerifyLoginMfaRequest(req *pb.VerifyLoginMfaRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetMfaChallengeToken() == "" {
+		violations = append(violations, fieldViolation("mfaChallengeToken", fmt.Errorf("must not be empty")))
+	}
+
+	switch {
+	case req.TotpCode != nil && req.RecoveryCode != nil:
+		violations = append(violations, fieldViolation("totpCode", fmt.Errorf("must not be set together with recoveryCode")))
+	case req.TotpCode != nil:
+		if err := val.ValidateTotpCode(req.GetTotpCode()); err != nil {
+			violations = append(violations, fieldViolation("totpCode", err))
+		}
+	case req.RecoveryCode != nil:
+		if err := val.ValidateRecoveryCode(req.GetRecoveryCode()); err != nil {
+			violations = append(violations, fieldViolation("recoveryCode", err))
+		}
+	default:
+		violations = append(violations, fieldViolation("totpCode", fmt.Errorf("either totpCode or recoveryCode is required")))
+	}
+	return violations
+}
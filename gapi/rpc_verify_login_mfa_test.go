@@ -0,0 +1,295 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/uwemakan/simplebank/db/mock"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/pb"
+	"github.com/uwemakan/simplebank/token"
+	"github.com/uwemakan/simplebank/totp"
+	"github.com/uwemakan/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func randomTotpCredential(t *testing.T, username string) db.TotpCredential {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	return db.TotpCredential{
+		Username:        username,
+		Secret:          secret,
+		IsEnabled:       true,
+		LastUsedCounter: totp.Counter(time.Now()) - 10,
+	}
+}
+
+func currentTotpCode(t *testing.T, secret string) string {
+	code, err := totp.GenerateCode(secret, totp.Counter(time.Now()))
+	require.NoError(t, err)
+	return code
+}
+
+// wrongTotpCode returns a well-formed code that matches none of the accepted time steps
+func wrongTotpCode(t *testing.T, secret string) string {
+	accepted := make(map[string]bool)
+	counter := totp.Counter(time.Now())
+	for i := counter - 1; i <= counter+1; i++ {
+		code, err := totp.GenerateCode(secret, i)
+		require.NoError(t, err)
+		accepted[code] = true
+	}
+
+	for {
+		code := fmt.Sprintf("%06d", util.RandomInt(0, 999999))
+		if !accepted[code] {
+			return code
+		}
+	}
+}
+
+func TestLoginUserMfaRequiredAPI(t *testing.T) {
+	password := util.RandomString(6)
+	user := randomUser(t, password)
+	credential := randomTotpCredential(t, user.Username)
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+
+	store := mockdb.NewMockStore(storeCtrl)
+	expectLoginGuard(t, store, user.Username, 0)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+	// the login only succeeds once the second factor is verified
+	store.EXPECT().CreateLoginAttempt(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	res, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	require.NoError(t, err)
+	require.True(t, res.GetMfaRequired())
+	require.Empty(t, res.GetAccessToken())
+	require.Empty(t, res.GetRefreshToken())
+	require.Nil(t, res.GetUser())
+
+	payload, err := server.tokenMaker.VerifyToken(res.GetMfaChallengeToken(), token.TokenTypeMfaChallenge)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, payload.Username)
+	require.WithinDuration(t, time.Now().Add(mfaChallengeDuration), res.GetMfaChallengeExpiresAt().AsTime(), time.Second)
+
+	// the challenge token is no access token
+	_, err = server.tokenMaker.VerifyToken(res.GetMfaChallengeToken(), token.TokenTypeAccessToken)
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+}
+
+func TestVerifyLoginMfaAPI(t *testing.T) {
+	user := randomUser(t, util.RandomString(6))
+	credential := randomTotpCredential(t, user.Username)
+	recoveryCodes, err := totp.GenerateRecoveryCodes(1)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		tokenType     token.TokenType
+		buildRequest  func(t *testing.T) *pb.VerifyLoginMfaRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			name:      "OKTotpCode",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{TotpCode: proto.String(currentTotpCode(t, credential.Secret))}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().
+					UpdateTotpLastUsedCounter(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.UpdateTotpLastUsedCounterParams) (db.TotpCredential, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.InDelta(t, totp.Counter(time.Now()), arg.LastUsedCounter, 1)
+						return credential, nil
+					})
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				expectLoginAttempt(t, store, user.Username, true)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetMfaRequired())
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name:      "OKRecoveryCode",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{RecoveryCode: proto.String(recoveryCodes[0])}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UseRecoveryCodeParams{
+					Username:   user.Username,
+					HashedCode: totp.HashRecoveryCode(recoveryCodes[0]),
+				}
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().UseRecoveryCode(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.RecoveryCode{IsUsed: true}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				expectLoginAttempt(t, store, user.Username, true)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			name:      "ReplayedTotpCode",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{TotpCode: proto.String(currentTotpCode(t, credential.Secret))}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().UpdateTotpLastUsedCounter(gomock.Any(), gomock.Any()).Times(1).Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "WrongTotpCode",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{TotpCode: proto.String(wrongTotpCode(t, credential.Secret))}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().UpdateTotpLastUsedCounter(gomock.Any(), gomock.Any()).Times(0)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "UsedRecoveryCode",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{RecoveryCode: proto.String(recoveryCodes[0])}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(credential, nil)
+				store.EXPECT().UseRecoveryCode(gomock.Any(), gomock.Any()).Times(1).Return(db.RecoveryCode{}, db.ErrRecordNotFound)
+				expectLoginFailure(t, store, user.Username)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "AccessTokenAsChallenge",
+			tokenType: token.TokenTypeAccessToken,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{TotpCode: proto.String(currentTotpCode(t, credential.Secret))}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name:      "NotEnabled",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{TotpCode: proto.String(currentTotpCode(t, credential.Secret))}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoginGuard(t, store, user.Username, 0)
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.TotpCredential{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name:      "InvalidArgument",
+			tokenType: token.TokenTypeMfaChallenge,
+			buildRequest: func(t *testing.T) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{
+					TotpCode:     proto.String(currentTotpCode(t, credential.Secret)),
+					RecoveryCode: proto.String(recoveryCodes[0]),
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTotpCredential(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+
+			store := mockdb.NewMockStore(storeCtrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			challengeToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute, tc.tokenType)
+			require.NoError(t, err)
+
+			req := tc.buildRequest(t)
+			req.MfaChallengeToken = challengeToken
+			res, err := server.VerifyLoginMfa(context.Background(), req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
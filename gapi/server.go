@@ -1,9 +1,13 @@
 package gapi
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/pb"
 	"github.com/uwemakan/simplebank/token"
 	"github.com/uwemakan/simplebank/util"
@@ -16,22 +20,57 @@ type Server struct {
 	config          util.Config
 	store           db.Store
 	tokenMaker      token.Maker
+	keyring         *token.Keyring
 	taskDistributor worker.TaskDistributor
+	rateProvider    fx.RateProvider
+	passwordChecker *token.PasswordChangeChecker
+	revocationChecker *token.RevocationChecker
 }
 
 // NewServer creates a new gRPC server and setup routing
 func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, keyring, err := token.NewMaker(config.TokenSigningKey, config.TokenVerificationKeys)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	rateProvider, err := fx.NewRateProvider(config.FxRatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rate provider: %w", err)
+	}
+
 	server := &Server{
 		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
+		keyring:    keyring,
 		taskDistributor: taskDistributor,
+		rateProvider: rateProvider,
 	}
+	server.passwordChecker = token.NewPasswordChangeChecker(server.passwordChangedAt, token.PasswordChangeCacheDuration)
+	server.revocationChecker = token.NewRevocationChecker(server.isTokenRevoked, token.RevocationCacheDuration)
 
 	return server, nil
 }
+
+// passwordChangedAt returns the time the user last changed their password
+func (server *Server) passwordChangedAt(ctx context.Context, username string) (time.Time, error) {
+	user, err := server.store.GetUser(ctx, username)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return user.PasswordChangedAt, nil
+}
+
+// isTokenRevoked reports whether the access token was revoked before it expired
+func (server *Server) isTokenRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	return server.store.IsTokenRevoked(ctx, tokenID)
+}
+
+// cacheRevokedTokens makes this server reject the revoked tokens right away,
+// instead of once their cached lookups expire
+func (server *Server) cacheRevokedTokens(revokedTokens []db.RevokedToken) {
+	for _, revokedToken := range revokedTokens {
+		server.revocationChecker.Revoke(revokedToken.ID, revokedToken.ExpiresAt)
+	}
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// currentSession returns the session that the refresh token belongs to,
+// after checking that it is owned by the authenticated user
+func (server *Server) currentSession(ctx context.Context, authPayload *token.Payload, refreshToken string) (db.Session, error) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken, token.TokenTypeRefreshToken)
+	if err != nil {
+		return db.Session{}, status.Errorf(codes.Unauthenticated, "invalid refresh token: %s", err)
+	}
+
+	if refreshPayload.Username != authPayload.Username {
+		return db.Session{}, status.Errorf(codes.PermissionDenied, "session doesn't belong to the authenticated user")
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return session, status.Errorf(codes.NotFound, "session not found")
+		}
+		return session, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if session.Username != authPayload.Username {
+		return session, status.Errorf(codes.PermissionDenied, "session doesn't belong to the authenticated user")
+	}
+
+	if session.RefreshToken != refreshToken {
+		return session, status.Errorf(codes.Unauthenticated, "mismatched session token")
+	}
+
+	if session.IsRotated {
+		return session, status.Errorf(codes.Unauthenticated, "%s", db.ErrSessionRotated)
+	}
+
+	return session, nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/uwemakan/simplebank/api"
 	db "github.com/uwemakan/simplebank/db/sqlc"
 	_ "github.com/uwemakan/simplebank/doc/statik"
+	"github.com/uwemakan/simplebank/fx"
 	"github.com/uwemakan/simplebank/gapi"
 	"github.com/uwemakan/simplebank/mail"
 	"github.com/uwemakan/simplebank/pb"
@@ -47,6 +48,10 @@ func main() {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
 
+	if config.TokenSigningKey == "" {
+		log.Fatal().Msg("TOKEN_SIGNING_KEY must be set, generate one with: openssl rand -base64 32")
+	}
+
 	connPool, err := pgxpool.New(context.Background(), config.DBSource)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Cannot connect to db")
@@ -54,7 +59,17 @@ func main() {
 
 	runDBMigration(config.MigrationURL, config.DBSource)
 
-	store := db.NewStore(connPool)
+	transferLimits, err := config.TransferLimits()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load transfer limits")
+	}
+
+	rateProvider, err := fx.NewRateProvider(config.FxRatesFile)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create rate provider")
+	}
+
+	store := db.NewStore(connPool, transferLimits, rateProvider)
 
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
@@ -63,6 +78,7 @@ func main() {
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 
 	go runTaskProcessor(config, redisOpt, store)
+	go runTaskScheduler(redisOpt)
 	go RunGatewayServer(config, store, taskDistributor)
 	RunGrpcServer(config, store, taskDistributor)
 }
@@ -92,6 +108,15 @@ func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store d
 	}
 }
 
+func runTaskScheduler(redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+	log.Info().Msg("start task scheduler")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
+	}
+}
+
 func RunGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
 	server, err := gapi.NewServer(config, store, taskDistributor)
 	if err != nil {
@@ -121,16 +146,20 @@ func RunGatewayServer(config util.Config, store db.Store, taskDistributor worker
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			UseProtoNames: true,
-		},
-		UnmarshalOptions: protojson.UnmarshalOptions{
-			DiscardUnknown: true,
+	// HttpBody responses, such as exported statements, are written as raw files instead of JSON
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
 		},
 	})
+	headerOption := runtime.WithOutgoingHeaderMatcher(gapi.OutgoingHeaderMatcher)
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(jsonOption, headerOption)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
@@ -140,6 +169,7 @@ func RunGatewayServer(config util.Config, store db.Store, taskDistributor worker
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.Handle(gapi.JWKSPath, server.JWKSHandler())
 
 	statikFs, err := fs.New()
 	if err != nil {
@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.23.4
+// source: rpc_create_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateFeeRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency     string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransferType string `protobuf:"bytes,2,opt,name=transferType,proto3" json:"transferType,omitempty"`
+	MinAmount    int64  `protobuf:"varint,3,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	FlatFee      int64  `protobuf:"varint,4,opt,name=flatFee,proto3" json:"flatFee,omitempty"`
+	BasisPoints  int64  `protobuf:"varint,5,opt,name=basisPoints,proto3" json:"basisPoints,omitempty"`
+	MaxFee       int64  `protobuf:"varint,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+}
+
+func (x *CreateFeeRuleRequest) Reset() {
+	*x = CreateFeeRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_fee_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeRuleRequest) ProtoMessage() {}
+
+func (x *CreateFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFeeRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateFeeRuleRequest) GetTransferType() string {
+	if x != nil {
+		return x.TransferType
+	}
+	return ""
+}
+
+func (x *CreateFeeRuleRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CreateFeeRuleRequest) GetFlatFee() int64 {
+	if x != nil {
+		return x.FlatFee
+	}
+	return 0
+}
+
+func (x *CreateFeeRuleRequest) GetBasisPoints() int64 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+func (x *CreateFeeRuleRequest) GetMaxFee() int64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+type CreateFeeRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeRule *FeeRule `protobuf:"bytes,1,opt,name=feeRule,proto3" json:"feeRule,omitempty"`
+}
+
+func (x *CreateFeeRuleResponse) Reset() {
+	*x = CreateFeeRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_fee_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeRuleResponse) ProtoMessage() {}
+
+func (x *CreateFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fee_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fee_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFeeRuleResponse) GetFeeRule() *FeeRule {
+	if x != nil {
+		return x.FeeRule
+	}
+	return nil
+}
+
+var File_rpc_create_fee_rule_proto protoreflect.FileDescriptor
+
+var file_rpc_create_fee_rule_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x0e, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc8, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x22, 0x3e, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x77, 0x65, 0x6d, 0x61, 0x6b, 0x61,
+	0x6e, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_fee_rule_proto_rawDescOnce sync.Once
+	file_rpc_create_fee_rule_proto_rawDescData = file_rpc_create_fee_rule_proto_rawDesc
+)
+
+func file_rpc_create_fee_rule_proto_rawDescGZIP() []byte {
+	file_rpc_create_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_create_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_fee_rule_proto_rawDescData)
+	})
+	return file_rpc_create_fee_rule_proto_rawDescData
+}
+
+var file_rpc_create_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_fee_rule_proto_goTypes = []interface{}{
+	(*CreateFeeRuleRequest)(nil),  // 0: pb.CreateFeeRuleRequest
+	(*CreateFeeRuleResponse)(nil), // 1: pb.CreateFeeRuleResponse
+	(*FeeRule)(nil),               // 2: pb.FeeRule
+}
+var file_rpc_create_fee_rule_proto_depIdxs = []int32{
+	2, // 0: pb.CreateFeeRuleResponse.feeRule:type_name -> pb.FeeRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_fee_rule_proto_init() }
+func file_rpc_create_fee_rule_proto_init() {
+	if File_rpc_create_fee_rule_proto != nil {
+		return
+	}
+	file_fee_rule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_fee_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFeeRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_fee_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFeeRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_fee_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_create_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_create_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_create_fee_rule_proto = out.File
+	file_rpc_create_fee_rule_proto_rawDesc = nil
+	file_rpc_create_fee_rule_proto_goTypes = nil
+	file_rpc_create_fee_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,214 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+const (
+	camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+	// camtNotProvided fills the references an entry does not have, as the standard asks
+	camtNotProvided = "NOTPROVIDED"
+	// camtMaxUnstructuredLength is the longest unstructured remittance information the schema allows
+	camtMaxUnstructuredLength = 140
+)
+
+type camtDocument struct {
+	XMLName   xml.Name      `xml:"Document"`
+	Namespace string        `xml:"xmlns,attr"`
+	Header    camtGroupHdr  `xml:"BkToCstmrStmt>GrpHdr"`
+	Statement camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtGroupHdr struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID       string        `xml:"Id"`
+	CreDtTm  string        `xml:"CreDtTm"`
+	FrDtTm   string        `xml:"FrToDt>FrDtTm"`
+	ToDtTm   string        `xml:"FrToDt>ToDtTm"`
+	Account  camtAccount   `xml:"Acct"`
+	Balances []camtBalance `xml:"Bal"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtAccount struct {
+	ID        string `xml:"Id>Othr>Id"`
+	Currency  string `xml:"Ccy"`
+	OwnerName string `xml:"Ownr>Nm,omitempty"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtBalance struct {
+	Type             string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount           camtAmount `xml:"Amt"`
+	CreditDebitIndic string     `xml:"CdtDbtInd"`
+	DateTime         string     `xml:"Dt>DtTm"`
+}
+
+type camtEntry struct {
+	Reference        string        `xml:"NtryRef"`
+	Amount           camtAmount    `xml:"Amt"`
+	CreditDebitIndic string        `xml:"CdtDbtInd"`
+	Status           string        `xml:"Sts"`
+	BookingDateTime  string        `xml:"BookgDt>DtTm"`
+	ValueDateTime    string        `xml:"ValDt>DtTm"`
+	AccountServicer  string        `xml:"AcctSvcrRef"`
+	TransactionCode  string        `xml:"BkTxCd>Prtry>Cd"`
+	Details          camtTxDetails `xml:"NtryDtls>TxDtls"`
+}
+
+type camtTxDetails struct {
+	EndToEndID   string     `xml:"Refs>EndToEndId"`
+	Debtor       *camtParty `xml:"RltdPties>Dbtr,omitempty"`
+	Creditor     *camtParty `xml:"RltdPties>Cdtr,omitempty"`
+	Unstructured string     `xml:"RmtInf>Ustrd,omitempty"`
+}
+
+type camtParty struct {
+	Name string `xml:"Nm"`
+}
+
+// exportCamt053 writes an ISO 20022 bank to customer statement, camt.053.001.02.
+// Amounts are unsigned, with a credit or debit indicator.
+func exportCamt053(statement Statement) ([]byte, error) {
+	currency := statement.Account.Currency
+	statementID := fmt.Sprintf("%d-%s", statement.Account.ID, statement.FromTime.UTC().Format("20060102"))
+
+	openingBalance, err := newCamtBalance("OPBD", statement.OpeningBalance, currency, statement.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	closingBalance, err := newCamtBalance("CLBD", statement.ClosingBalance, currency, statement.ToTime)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := camtDocument{
+		Namespace: camt053Namespace,
+		Header: camtGroupHdr{
+			MsgID:   fmt.Sprintf("%s-%d", statementID, statement.CreatedAt.Unix()),
+			CreDtTm: formatCamtTime(statement.CreatedAt),
+		},
+		Statement: camtStatement{
+			ID:      statementID,
+			CreDtTm: formatCamtTime(statement.CreatedAt),
+			FrDtTm:  formatCamtTime(statement.FromTime),
+			ToDtTm:  formatCamtTime(statement.ToTime),
+			Account: camtAccount{
+				ID:        strconv.FormatInt(statement.Account.ID, 10),
+				Currency:  currency,
+				OwnerName: statement.OwnerName,
+			},
+			Balances: []camtBalance{openingBalance, closingBalance},
+			Entries:  make([]camtEntry, len(statement.Lines)),
+		},
+	}
+
+	for i, line := range statement.Lines {
+		amount, indicator, err := formatCamtAmount(line.Entry.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		endToEndID := line.Entry.Reference
+		if endToEndID == "" {
+			endToEndID = camtNotProvided
+		}
+
+		unstructured := line.Entry.Description
+		if len(unstructured) > camtMaxUnstructuredLength {
+			unstructured = unstructured[:camtMaxUnstructuredLength]
+		}
+
+		details := camtTxDetails{
+			EndToEndID:   endToEndID,
+			Unstructured: unstructured,
+		}
+		if line.Counterparty != "" {
+			if line.Entry.Amount < 0 {
+				details.Creditor = &camtParty{Name: line.Counterparty}
+			} else {
+				details.Debtor = &camtParty{Name: line.Counterparty}
+			}
+		}
+
+		entryID := strconv.FormatInt(line.Entry.ID, 10)
+		doc.Statement.Entries[i] = camtEntry{
+			Reference:        entryID,
+			Amount:           amount,
+			CreditDebitIndic: indicator,
+			Status:           "BOOK",
+			BookingDateTime:  formatCamtTime(line.Entry.CreatedAt),
+			ValueDateTime:    formatCamtTime(line.Entry.CreatedAt),
+			AccountServicer:  entryID,
+			TransactionCode:  camtTransactionCode(line),
+			Details:          details,
+		}
+	}
+
+	content, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+func newCamtBalance(balanceType string, balance int64, currency string, at time.Time) (camtBalance, error) {
+	amount, indicator, err := formatCamtAmount(balance, currency)
+	if err != nil {
+		return camtBalance{}, err
+	}
+
+	camtBalance := camtBalance{
+		Type:             balanceType,
+		Amount:           amount,
+		CreditDebitIndic: indicator,
+		DateTime:         formatCamtTime(at),
+	}
+	return camtBalance, nil
+}
+
+func formatCamtAmount(amount int64, currency string) (camtAmount, string, error) {
+	indicator := "CRDT"
+	if amount < 0 {
+		indicator = "DBIT"
+		amount = -amount
+	}
+
+	value, err := util.FormatAmount(amount, currency)
+	if err != nil {
+		return camtAmount{}, "", err
+	}
+	return camtAmount{Currency: currency, Value: value}, indicator, nil
+}
+
+func camtTransactionCode(line db.StatementLine) string {
+	switch line.JournalKind {
+	case db.JournalKindTransfer:
+		return "TRANSFER"
+	case db.JournalKindReversal:
+		return "REVERSAL"
+	case db.JournalKindDeposit:
+		return "DEPOSIT"
+	case db.JournalKindWithdrawal:
+		return "WITHDRAWAL"
+	}
+	return "OTHER"
+}
+
+func formatCamtTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,99 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uwemakan/simplebank/util"
+)
+
+var csvHeader = []string{
+	"date",
+	"entry_id",
+	"type",
+	"reference",
+	"description",
+	"counterparty",
+	"counterparty_account_id",
+	"transfer_id",
+	"amount",
+	"balance",
+	"currency",
+}
+
+// exportCSV writes one row per entry, between an opening and a closing balance row.
+// Amounts are decimal strings in the account currency, so spreadsheets do not need to know its minor unit.
+func exportCSV(statement Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	currency := statement.Account.Currency
+
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	balanceRow := func(date time.Time, kind string, balance int64) error {
+		formatted, err := util.FormatAmount(balance, currency)
+		if err != nil {
+			return err
+		}
+		return w.Write([]string{date.UTC().Format(time.RFC3339), "", kind, "", "", "", "", "", "", formatted, currency})
+	}
+
+	if err := balanceRow(statement.FromTime, "opening_balance", statement.OpeningBalance); err != nil {
+		return nil, err
+	}
+
+	for _, line := range statement.Lines {
+		amount, err := util.FormatAmount(line.Entry.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := util.FormatAmount(line.Balance, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		row := []string{
+			line.Entry.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(line.Entry.ID, 10),
+			line.JournalKind,
+			escapeFormula(line.Entry.Reference),
+			escapeFormula(line.Entry.Description),
+			escapeFormula(line.Counterparty),
+			formatOptionalID(line.CounterpartyAccountID),
+			formatOptionalID(line.TransferID),
+			amount,
+			balance,
+			currency,
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := balanceRow(statement.ToTime, "closing_balance", statement.ClosingBalance); err != nil {
+		return nil, err
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func formatOptionalID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// escapeFormula keeps spreadsheets from evaluating free text, such as a description, as a formula
+func escapeFormula(field string) string {
+	if field != "" && strings.ContainsRune("=+-@\t\r", rune(field[0])) {
+		return "'" + field
+	}
+	return field
+}
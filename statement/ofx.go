@@ -0,0 +1,158 @@
+package statement
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+const (
+	ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" +
+		`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+	ofxBankID     = "SIMPLEBANK"
+	ofxTimeFormat = "20060102150405.000"
+	// ofxMaxNameLength is the longest payee name OFX allows
+	ofxMaxNameLength = 32
+)
+
+type ofxDocument struct {
+	XMLName xml.Name   `xml:"OFX"`
+	SignOn  ofxSignOn  `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxBankMsg `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxBankMsg struct {
+	TrnUID    string       `xml:"TRNUID"`
+	Status    ofxStatus    `xml:"STATUS"`
+	Statement ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	CurDef    string      `xml:"CURDEF"`
+	Account   ofxAccount  `xml:"BANKACCTFROM"`
+	TranList  ofxTranList `xml:"BANKTRANLIST"`
+	LedgerBal ofxBalance  `xml:"LEDGERBAL"`
+}
+
+type ofxAccount struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxTranList struct {
+	DTStart      string           `xml:"DTSTART"`
+	DTEnd        string           `xml:"DTEND"`
+	Transactions []ofxTransaction `xml:"STMTTRN"`
+}
+
+type ofxTransaction struct {
+	TrnType  string `xml:"TRNTYPE"`
+	DTPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FitID    string `xml:"FITID"`
+	Name     string `xml:"NAME,omitempty"`
+	Memo     string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	BalAmt string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
+
+// exportOFX writes an OFX 2.2 bank statement response, as imported by accounting software
+func exportOFX(statement Statement) ([]byte, error) {
+	currency := statement.Account.Currency
+
+	closingBalance, err := util.FormatAmount(statement.ClosingBalance, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ofxStatus{Code: 0, Severity: "INFO"},
+			DTServer: formatOFXTime(statement.CreatedAt),
+			Language: "ENG",
+		},
+		Bank: ofxBankMsg{
+			TrnUID: "0",
+			Status: ofxStatus{Code: 0, Severity: "INFO"},
+			Statement: ofxStatement{
+				CurDef: currency,
+				Account: ofxAccount{
+					BankID:   ofxBankID,
+					AcctID:   strconv.FormatInt(statement.Account.ID, 10),
+					AcctType: "CHECKING",
+				},
+				TranList: ofxTranList{
+					DTStart:      formatOFXTime(statement.FromTime),
+					DTEnd:        formatOFXTime(statement.ToTime),
+					Transactions: make([]ofxTransaction, len(statement.Lines)),
+				},
+				LedgerBal: ofxBalance{
+					BalAmt: closingBalance,
+					DTAsOf: formatOFXTime(statement.ToTime),
+				},
+			},
+		},
+	}
+
+	for i, line := range statement.Lines {
+		amount, err := util.FormatAmount(line.Entry.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		name := line.Counterparty
+		if len(name) > ofxMaxNameLength {
+			name = name[:ofxMaxNameLength]
+		}
+
+		doc.Bank.Statement.TranList.Transactions[i] = ofxTransaction{
+			TrnType:  ofxTransactionType(line),
+			DTPosted: formatOFXTime(line.Entry.CreatedAt),
+			TrnAmt:   amount,
+			FitID:    strconv.FormatInt(line.Entry.ID, 10),
+			Name:     name,
+			Memo:     line.Entry.Description,
+		}
+	}
+
+	content, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(ofxHeader), content...), nil
+}
+
+func ofxTransactionType(line db.StatementLine) string {
+	switch line.JournalKind {
+	case db.JournalKindTransfer, db.JournalKindReversal:
+		return "XFER"
+	case db.JournalKindDeposit:
+		return "DEP"
+	}
+	if line.Entry.Amount < 0 {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+func formatOFXTime(t time.Time) string {
+	return t.UTC().Format(ofxTimeFormat) + "[0:GMT]"
+}
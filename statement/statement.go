@@ -0,0 +1,65 @@
+// Package statement exports account statements as files for spreadsheets and accounting software
+package statement
+
+import (
+	"fmt"
+	"time"
+
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+// Statement is an account statement to export
+type Statement struct {
+	Account db.Account
+	// OwnerName is the full name of the account owner
+	OwnerName string
+	db.StatementTxResult
+	CreatedAt time.Time
+}
+
+// File is an exported statement
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+type exporter struct {
+	extension   string
+	contentType string
+	export      func(statement Statement) ([]byte, error)
+}
+
+var exporters = map[string]exporter{
+	util.CSVFormat:     {extension: "csv", contentType: "text/csv", export: exportCSV},
+	util.OFXFormat:     {extension: "ofx", contentType: "application/x-ofx", export: exportOFX},
+	util.Camt053Format: {extension: "xml", contentType: "application/xml", export: exportCamt053},
+}
+
+// Export renders the statement as a file in the format
+func Export(format string, statement Statement) (File, error) {
+	exporter, ok := exporters[format]
+	if !ok {
+		return File{}, fmt.Errorf("unsupported statement format: %s", format)
+	}
+
+	content, err := exporter.export(statement)
+	if err != nil {
+		return File{}, fmt.Errorf("failed to export %s statement: %w", format, err)
+	}
+
+	name := fmt.Sprintf("statement-%d-%s-%s.%s",
+		statement.Account.ID,
+		statement.FromTime.UTC().Format("20060102"),
+		statement.ToTime.UTC().Format("20060102"),
+		exporter.extension,
+	)
+
+	file := File{
+		Name:        name,
+		ContentType: exporter.contentType,
+		Content:     content,
+	}
+	return file, nil
+}
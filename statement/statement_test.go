@@ -0,0 +1,167 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func randomStatement() Statement {
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    util.RandomOwner(),
+		Currency: util.USD,
+	}
+	toTime := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	return Statement{
+		Account:   account,
+		OwnerName: "Jane Doe",
+		StatementTxResult: db.StatementTxResult{
+			AccountID:      account.ID,
+			FromTime:       fromTime,
+			ToTime:         toTime,
+			OpeningBalance: 10000,
+			Lines: []db.StatementLine{
+				{
+					ListStatementEntriesRow: db.ListStatementEntriesRow{
+						Entry: db.Entry{
+							ID:          1,
+							AccountID:   account.ID,
+							Amount:      -2550,
+							CreatedAt:   fromTime.Add(time.Hour),
+							Description: "=rent",
+						},
+						JournalKind:           db.JournalKindTransfer,
+						TransferID:            7,
+						CounterpartyAccountID: 42,
+						Counterparty:          "landlord",
+					},
+					Balance: 7450,
+				},
+				{
+					ListStatementEntriesRow: db.ListStatementEntriesRow{
+						Entry: db.Entry{
+							ID:        2,
+							AccountID: account.ID,
+							Amount:    500,
+							CreatedAt: fromTime.Add(2 * time.Hour),
+							Reference: "TELLER-0042",
+						},
+						JournalKind: db.JournalKindDeposit,
+					},
+					Balance: 7950,
+				},
+			},
+			ClosingBalance: 7950,
+		},
+		CreatedAt: toTime.Add(time.Hour),
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	statement := randomStatement()
+
+	file, err := Export(util.CSVFormat, statement)
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", file.ContentType)
+	require.Equal(t, "statement-"+itoa(statement.Account.ID)+"-20230901-20231001.csv", file.Name)
+
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+	require.Equal(t, csvHeader, rows[0])
+
+	require.Equal(t, "opening_balance", rows[1][2])
+	require.Equal(t, "100.00", rows[1][9])
+
+	require.Equal(t, "1", rows[2][1])
+	require.Equal(t, db.JournalKindTransfer, rows[2][2])
+	require.Equal(t, "'=rent", rows[2][4])
+	require.Equal(t, "landlord", rows[2][5])
+	require.Equal(t, "42", rows[2][6])
+	require.Equal(t, "-25.50", rows[2][8])
+	require.Equal(t, "74.50", rows[2][9])
+
+	require.Equal(t, "TELLER-0042", rows[3][3])
+	require.Empty(t, rows[3][6])
+
+	require.Equal(t, "closing_balance", rows[4][2])
+	require.Equal(t, "79.50", rows[4][9])
+}
+
+func TestExportOFX(t *testing.T) {
+	statement := randomStatement()
+
+	file, err := Export(util.OFXFormat, statement)
+	require.NoError(t, err)
+	require.Equal(t, "application/x-ofx", file.ContentType)
+	require.Contains(t, string(file.Content), `<?OFX OFXHEADER="200" VERSION="220"`)
+
+	var doc ofxDocument
+	err = xml.Unmarshal(file.Content, &doc)
+	require.NoError(t, err)
+
+	stmt := doc.Bank.Statement
+	require.Equal(t, util.USD, stmt.CurDef)
+	require.Equal(t, itoa(statement.Account.ID), stmt.Account.AcctID)
+	require.Equal(t, "20230901000000.000[0:GMT]", stmt.TranList.DTStart)
+	require.Equal(t, "79.50", stmt.LedgerBal.BalAmt)
+
+	require.Len(t, stmt.TranList.Transactions, 2)
+	require.Equal(t, "XFER", stmt.TranList.Transactions[0].TrnType)
+	require.Equal(t, "-25.50", stmt.TranList.Transactions[0].TrnAmt)
+	require.Equal(t, "landlord", stmt.TranList.Transactions[0].Name)
+	require.Equal(t, "DEP", stmt.TranList.Transactions[1].TrnType)
+	require.Equal(t, "5.00", stmt.TranList.Transactions[1].TrnAmt)
+}
+
+func TestExportCamt053(t *testing.T) {
+	statement := randomStatement()
+
+	file, err := Export(util.Camt053Format, statement)
+	require.NoError(t, err)
+	require.Equal(t, "application/xml", file.ContentType)
+
+	var doc camtDocument
+	err = xml.Unmarshal(file.Content, &doc)
+	require.NoError(t, err)
+
+	stmt := doc.Statement
+	require.Equal(t, itoa(statement.Account.ID), stmt.Account.ID)
+	require.Equal(t, statement.OwnerName, stmt.Account.OwnerName)
+
+	require.Len(t, stmt.Balances, 2)
+	require.Equal(t, "OPBD", stmt.Balances[0].Type)
+	require.Equal(t, "100.00", stmt.Balances[0].Amount.Value)
+	require.Equal(t, "CLBD", stmt.Balances[1].Type)
+	require.Equal(t, "79.50", stmt.Balances[1].Amount.Value)
+	require.Equal(t, "CRDT", stmt.Balances[1].CreditDebitIndic)
+
+	require.Len(t, stmt.Entries, 2)
+	require.Equal(t, "25.50", stmt.Entries[0].Amount.Value)
+	require.Equal(t, util.USD, stmt.Entries[0].Amount.Currency)
+	require.Equal(t, "DBIT", stmt.Entries[0].CreditDebitIndic)
+	require.Equal(t, "landlord", stmt.Entries[0].Details.Creditor.Name)
+	require.Equal(t, camtNotProvided, stmt.Entries[0].Details.EndToEndID)
+	require.Equal(t, "CRDT", stmt.Entries[1].CreditDebitIndic)
+	require.Equal(t, "TELLER-0042", stmt.Entries[1].Details.EndToEndID)
+	require.Equal(t, "DEPOSIT", stmt.Entries[1].TransactionCode)
+}
+
+func TestExportUnsupportedFormat(t *testing.T) {
+	_, err := Export("pdf", randomStatement())
+	require.Error(t, err)
+}
+
+func itoa(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
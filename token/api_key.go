@@ -0,0 +1,94 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// apiKeyPrefix starts every API key, so that leaked keys are easy to recognize
+const apiKeyPrefix = "sbk"
+
+// apiKeySecretSize is the number of random bytes in the secret of an API key
+const apiKeySecretSize = 32
+
+// MaxAPIKeyDuration caps how long an API key may stay valid
+const MaxAPIKeyDuration = 365 * 24 * time.Hour
+
+// Different types of errors returned by the API key functions
+var (
+	ErrInvalidAPIKey = errors.New("API key is invalid")
+	ErrExpiredAPIKey = errors.New("API key has expired")
+	ErrRevokedAPIKey = errors.New("API key has been revoked")
+)
+
+// NewAPIKey generates the key of the stored API key with the given id.
+// The key is only handed to the client; the server keeps the returned hash of its secret.
+func NewAPIKey(id uuid.UUID) (key string, hashedSecret string, err error) {
+	bytes := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", "", err
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(bytes)
+	key = fmt.Sprintf("%s_%s_%s", apiKeyPrefix, hex.EncodeToString(id[:]), secret)
+	return key, HashAPIKeySecret(secret), nil
+}
+
+// ParseAPIKey splits an API key into the id of the stored key and its secret
+func ParseAPIKey(key string) (uuid.UUID, string, error) {
+	// the secret may contain underscores itself
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || len(parts[1]) != 2*len(uuid.UUID{}) || parts[2] == "" {
+		return uuid.Nil, "", ErrInvalidAPIKey
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidAPIKey
+	}
+	return id, parts[2], nil
+}
+
+// HashAPIKeySecret hashes the secret of an API key for storage.
+// Unlike a password the secret is 256 random bits, so a fast hash is enough.
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAPIKey checks the secret of an API key against the stored hash,
+// and that the stored key is neither revoked nor expired
+func VerifyAPIKey(secret string, hashedSecret string, isRevoked bool, expiresAt time.Time) error {
+	if subtle.ConstantTimeCompare([]byte(HashAPIKeySecret(secret)), []byte(hashedSecret)) != 1 {
+		return ErrInvalidAPIKey
+	}
+	if isRevoked {
+		return ErrRevokedAPIKey
+	}
+	if time.Now().After(expiresAt) {
+		return ErrExpiredAPIKey
+	}
+	return nil
+}
+
+// NewAPIKeyPayload returns the payload that the requests authenticated with an API key act with,
+// on behalf of the owner of the key
+func NewAPIKeyPayload(id uuid.UUID, username string, role string, createdAt time.Time, expiresAt time.Time) *Payload {
+	return &Payload{
+		ID:        id,
+		Type:      TokenTypeAPIKey,
+		Username:  username,
+		Role:      role,
+		IssuedAt:  createdAt,
+		ExpiredAt: expiresAt,
+	}
+}
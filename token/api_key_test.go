@@ -0,0 +1,52 @@
+package token
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKey(t *testing.T) {
+	id := uuid.New()
+
+	key, hashedSecret, err := NewAPIKey(id)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, apiKeyPrefix+"_"))
+	require.NotContains(t, key, hashedSecret)
+
+	parsedID, secret, err := ParseAPIKey(key)
+	require.NoError(t, err)
+	require.Equal(t, id, parsedID)
+	require.Equal(t, hashedSecret, HashAPIKeySecret(secret))
+
+	expiresAt := time.Now().Add(time.Hour)
+	require.NoError(t, VerifyAPIKey(secret, hashedSecret, false, expiresAt))
+	require.ErrorIs(t, VerifyAPIKey(secret+"x", hashedSecret, false, expiresAt), ErrInvalidAPIKey)
+	require.ErrorIs(t, VerifyAPIKey(secret, hashedSecret, true, expiresAt), ErrRevokedAPIKey)
+	require.ErrorIs(t, VerifyAPIKey(secret, hashedSecret, false, time.Now().Add(-time.Second)), ErrExpiredAPIKey)
+
+	otherKey, otherHashedSecret, err := NewAPIKey(id)
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+	require.NotEqual(t, hashedSecret, otherHashedSecret)
+}
+
+func TestParseInvalidAPIKey(t *testing.T) {
+	id := strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	for _, key := range []string{
+		"",
+		"sbk",
+		"sbk_" + id,
+		"sbk_" + id + "_",
+		"abc_" + id + "_secret",
+		"sbk_" + uuid.NewString() + "_secret",
+		"sbk_" + strings.Repeat("z", len(id)) + "_secret",
+	} {
+		_, _, err := ParseAPIKey(key)
+		require.ErrorIs(t, err, ErrInvalidAPIKey, key)
+	}
+}
@@ -0,0 +1,81 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// keyIDHeader is the JWT header naming the key a token was signed with
+const keyIDHeader = "kid"
+
+// EdDSAMaker is a json web token maker signing with the Ed25519 key of a keyring,
+// so that tokens can be verified with the public keys alone
+type EdDSAMaker struct {
+	keyring *Keyring
+}
+
+// NewEdDSAMaker creates a new EdDSAMaker
+func NewEdDSAMaker(keyring *Keyring) (Maker, error) {
+	if keyring == nil {
+		return nil, errors.New("missing keyring")
+	}
+
+	return &EdDSAMaker{keyring}, nil
+}
+
+// CreateToken creates a token for a specific username and duration, signed with the current signing key
+func (maker EdDSAMaker) CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration, tokenType)
+	if err != nil {
+		return "", payload, err
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, payload)
+	jwtToken.Header[keyIDHeader] = maker.keyring.SigningKeyID()
+
+	token, err := jwtToken.SignedString(maker.keyring.signingKey)
+	return token, payload, err
+}
+
+// VerifyToken checks if token is valid and signed by one of the keys in the keyring
+func (maker EdDSAMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
+	keyFunc := func(tk *jwt.Token) (interface{}, error) {
+		_, ok := tk.Method.(*jwt.SigningMethodEd25519)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		keyID, ok := tk.Header[keyIDHeader].(string)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		publicKey, ok := maker.keyring.PublicKey(keyID)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return publicKey, nil
+	}
+	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	payload, ok := jwtToken.Claims.(*Payload)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.checkType(tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,133 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func newTestKeyring(t *testing.T, verificationKeys ...string) (*Keyring, string) {
+	signingKey, publicKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	keyring, err := NewKeyring(signingKey, verificationKeys)
+	require.NoError(t, err)
+	return keyring, publicKey
+}
+
+func TestEdDSAMaker(t *testing.T) {
+	keyring, _ := newTestKeyring(t)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, util.BankerRole, duration, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	jwtToken, _, err := new(jwt.Parser).ParseUnverified(token, &Payload{})
+	require.NoError(t, err)
+	require.Equal(t, "EdDSA", jwtToken.Header["alg"])
+	require.Equal(t, keyring.SigningKeyID(), jwtToken.Header[keyIDHeader])
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, TokenTypeAccessToken, payload.Type)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, util.BankerRole, payload.Role)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+}
+
+func TestExpiredEdDSAToken(t *testing.T) {
+	keyring, _ := newTestKeyring(t)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestEdDSAKeyRotation(t *testing.T) {
+	oldKeyring, oldPublicKey := newTestKeyring(t)
+	oldMaker, err := NewEdDSAMaker(oldKeyring)
+	require.NoError(t, err)
+
+	token, _, err := oldMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	// the old key is still accepted while it stays in the keyring
+	newKeyring, _ := newTestKeyring(t, oldPublicKey)
+	newMaker, err := NewEdDSAMaker(newKeyring)
+	require.NoError(t, err)
+	require.NotEqual(t, oldKeyring.SigningKeyID(), newKeyring.SigningKeyID())
+
+	payload, err := newMaker.VerifyToken(token, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	// and rejected once it is retired
+	retiredKeyring, _ := newTestKeyring(t)
+	retiredMaker, err := NewEdDSAMaker(retiredKeyring)
+	require.NoError(t, err)
+
+	payload, err = retiredMaker.VerifyToken(token, TokenTypeAccessToken)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidEdDSATokenHMAC(t *testing.T) {
+	keyring, publicKey := newTestKeyring(t)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), util.AdminRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	// a token signed with the public key as an HMAC secret must not pass
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
+	jwtToken.Header[keyIDHeader] = keyring.SigningKeyID()
+	token, err := jwtToken.SignedString([]byte(publicKey))
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestEdDSATokenWrongType(t *testing.T) {
+	keyring, _ := newTestKeyring(t)
+	maker, err := NewEdDSAMaker(keyring)
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeRefreshToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.Equal(t, TokenTypeRefreshToken, payload.Type)
+
+	payload, err = maker.VerifyToken(token, TokenTypeAccessToken)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
@@ -25,8 +25,8 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 }
 
 // CreateToken creates a token for a specific username and duration
-func (maker JWTMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+func (maker JWTMaker) CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration, tokenType)
 	
 	if err != nil {
 		return "", payload, err
@@ -38,7 +38,7 @@ func (maker JWTMaker) CreateToken(username string, duration time.Duration) (stri
 }
 
 // VerifyToken checks if token is valid or not
-func (maker JWTMaker) VerifyToken(token string) (*Payload, error) {
+func (maker JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	keyFunc := func(tk *jwt.Token) (interface{}, error) {
 		_, ok := tk.Method.(*jwt.SigningMethodHMAC)
 		if !ok {
@@ -60,6 +60,11 @@ func (maker JWTMaker) VerifyToken(token string) (*Payload, error) {
 	if !ok {
 		return nil, ErrInvalidToken
 	}
+
+	err = payload.checkType(tokenType)
+	if err != nil {
+		return nil, err
+	}
 	
 	return payload, nil
 }
\ No newline at end of file
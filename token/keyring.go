@@ -0,0 +1,133 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Keyring holds the Ed25519 key that signs new tokens and the public keys that tokens are verified against.
+// Keys are rotated by signing with a new key while the previous public key stays in the keyring
+// until every token it signed has expired.
+type Keyring struct {
+	signingKeyID string
+	signingKey   ed25519.PrivateKey
+	keyIDs       []string
+	publicKeys   map[string]ed25519.PublicKey
+}
+
+// NewKeyring creates a keyring from a base64 encoded Ed25519 seed used for signing,
+// and base64 encoded public keys of other keys that are still accepted
+func NewKeyring(signingKey string, verificationKeys []string) (*Keyring, error) {
+	seed, err := base64.StdEncoding.DecodeString(signingKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid signing key size: must be exactly %d bytes", ed25519.SeedSize)
+	}
+
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	keyring := &Keyring{
+		signingKey: privateKey,
+		publicKeys: make(map[string]ed25519.PublicKey),
+	}
+	keyring.signingKeyID = keyring.add(privateKey.Public().(ed25519.PublicKey))
+
+	for _, verificationKey := range verificationKeys {
+		verificationKey = strings.TrimSpace(verificationKey)
+		if verificationKey == "" {
+			continue
+		}
+
+		publicKey, err := base64.StdEncoding.DecodeString(verificationKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification key: %w", err)
+		}
+		if len(publicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid verification key size: must be exactly %d bytes", ed25519.PublicKeySize)
+		}
+
+		keyring.add(publicKey)
+	}
+
+	return keyring, nil
+}
+
+func (keyring *Keyring) add(publicKey ed25519.PublicKey) string {
+	keyID := keyThumbprint(publicKey)
+	if _, ok := keyring.publicKeys[keyID]; !ok {
+		keyring.keyIDs = append(keyring.keyIDs, keyID)
+		keyring.publicKeys[keyID] = publicKey
+	}
+	return keyID
+}
+
+// SigningKeyID returns the ID of the key new tokens are signed with
+func (keyring *Keyring) SigningKeyID() string {
+	return keyring.signingKeyID
+}
+
+// PublicKey returns the public key with the given ID, if it is in the keyring
+func (keyring *Keyring) PublicKey(keyID string) (ed25519.PublicKey, bool) {
+	publicKey, ok := keyring.publicKeys[keyID]
+	return publicKey, ok
+}
+
+// JSONWebKey is an Ed25519 public key in JWK format (RFC 8037)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+// JSONWebKeySet is the document published for other services to verify tokens with
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns all public keys of the keyring, the signing key first
+func (keyring *Keyring) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{
+		Keys: make([]JSONWebKey, 0, len(keyring.keyIDs)),
+	}
+
+	for _, keyID := range keyring.keyIDs {
+		set.Keys = append(set.Keys, JSONWebKey{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(keyring.publicKeys[keyID]),
+			KeyID:     keyID,
+			Use:       "sig",
+			Algorithm: "EdDSA",
+		})
+	}
+
+	return set
+}
+
+// keyThumbprint computes the key ID as the JWK thumbprint of the public key (RFC 7638)
+func keyThumbprint(publicKey ed25519.PublicKey) string {
+	x := base64.RawURLEncoding.EncodeToString(publicKey)
+	sum := sha256.Sum256([]byte(`{"crv":"Ed25519","kty":"OKP","x":"` + x + `"}`))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// GenerateSigningKey creates a new random base64 encoded Ed25519 seed
+// together with its base64 encoded public key
+func GenerateSigningKey() (signingKey string, publicKey string, err error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	signingKey = base64.StdEncoding.EncodeToString(private.Seed())
+	publicKey = base64.StdEncoding.EncodeToString(public)
+	return signingKey, publicKey, nil
+}
@@ -0,0 +1,77 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestKeyringJWKS(t *testing.T) {
+	_, oldPublicKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	keyring, publicKey := newTestKeyring(t, oldPublicKey, "", oldPublicKey)
+
+	jwks := keyring.JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	for i, encoded := range []string{publicKey, oldPublicKey} {
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+
+		key := jwks.Keys[i]
+		require.Equal(t, "OKP", key.KeyType)
+		require.Equal(t, "Ed25519", key.Curve)
+		require.Equal(t, "EdDSA", key.Algorithm)
+		require.Equal(t, "sig", key.Use)
+		require.Equal(t, base64.RawURLEncoding.EncodeToString(raw), key.X)
+
+		found, ok := keyring.PublicKey(key.KeyID)
+		require.True(t, ok)
+		require.Equal(t, ed25519.PublicKey(raw), found)
+	}
+	require.Equal(t, keyring.SigningKeyID(), jwks.Keys[0].KeyID)
+}
+
+func TestKeyThumbprint(t *testing.T) {
+	// RFC 8037 appendix A.3
+	x, err := base64.RawURLEncoding.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	require.NoError(t, err)
+	require.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", keyThumbprint(x))
+}
+
+func TestInvalidKeyring(t *testing.T) {
+	_, err := NewKeyring(util.RandomString(32), nil)
+	require.Error(t, err)
+
+	_, err = NewKeyring(base64.StdEncoding.EncodeToString([]byte(util.RandomString(16))), nil)
+	require.Error(t, err)
+
+	signingKey, publicKey, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	_, err = NewKeyring(signingKey, []string{publicKey[:8]})
+	require.Error(t, err)
+
+	_, err = NewKeyring(signingKey, []string{base64.StdEncoding.EncodeToString([]byte(util.RandomString(16)))})
+	require.Error(t, err)
+
+	_, err = NewEdDSAMaker(nil)
+	require.Error(t, err)
+}
+
+func TestNewMakerRequiresSigningKey(t *testing.T) {
+	_, _, err := NewMaker("", nil)
+	require.ErrorIs(t, err, ErrMissingSigningKey)
+
+	signingKey, _, err := GenerateSigningKey()
+	require.NoError(t, err)
+
+	maker, keyring, err := NewMaker(signingKey, nil)
+	require.NoError(t, err)
+	require.NotNil(t, keyring)
+	require.IsType(t, &EdDSAMaker{}, maker)
+}
@@ -1,12 +1,34 @@
 package token
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 // Maker is an interface for managing tokens
 type Maker interface {
-	// CreateToken creates a token for a specific username and duration
-	CreateToken(username string, duration time.Duration) (string, *Payload, error)
+	// CreateToken creates a token of the given type for a specific username, role and duration
+	CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error)
 
-	// VerifyToken checks if token is valid or not
-	VerifyToken(token string) (*Payload, error)
+	// VerifyToken checks if token is valid and was issued with the given type
+	VerifyToken(token string, tokenType TokenType) (*Payload, error)
+}
+
+// ErrMissingSigningKey is returned by NewMaker when no signing key is configured
+var ErrMissingSigningKey = errors.New("token signing key is required")
+
+// NewMaker creates an EdDSAMaker that signs tokens with the signing key, so that tokens can be verified
+// with the published public keys. There is no symmetric fallback: it returns ErrMissingSigningKey without a signing key.
+func NewMaker(signingKey string, verificationKeys []string) (Maker, *Keyring, error) {
+	if signingKey == "" {
+		return nil, nil, ErrMissingSigningKey
+	}
+
+	keyring, err := NewKeyring(signingKey, verificationKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maker, err := NewEdDSAMaker(keyring)
+	return maker, keyring, err
 }
@@ -29,8 +29,8 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 }
 
 // CreateToken creates a token for a specific username and duration
-func (maker PasetoMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+func (maker PasetoMaker) CreateToken(username string, role string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration, tokenType)
 	
 	if err != nil {
 		return "", payload, err
@@ -41,7 +41,7 @@ func (maker PasetoMaker) CreateToken(username string, duration time.Duration) (s
 }
 
 // VerifyToken checks if token is valid or not
-func (maker PasetoMaker) VerifyToken(token string) (*Payload, error) {
+func (maker PasetoMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	payload := &Payload{}
 
 	err := maker.paseto.Decrypt(token, maker.symmetricKey, payload, nil)
@@ -56,5 +56,11 @@ func (maker PasetoMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, err
 	}
 
+	err = payload.checkType(tokenType)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return payload, nil
 }
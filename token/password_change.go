@@ -0,0 +1,100 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// PasswordChangeCacheDuration is how long the password change time of a user is cached
+const PasswordChangeCacheDuration = 30 * time.Second
+
+// maxCachedPasswordChanges is the cache size above which expired entries are removed
+const maxCachedPasswordChanges = 1024
+
+var ErrTokenBeforePasswordChange = errors.New("token was issued before the last password change")
+
+// PasswordChangedAtFunc returns the time the user last changed their password
+type PasswordChangedAtFunc func(ctx context.Context, username string) (time.Time, error)
+
+// PasswordChangeChecker rejects tokens issued before the last password change of their user.
+// Password change times are cached for a short while, so that checking a token
+// doesn't add a database query to every request.
+type PasswordChangeChecker struct {
+	passwordChangedAt PasswordChangedAtFunc
+	cacheDuration     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedPasswordChange
+}
+
+type cachedPasswordChange struct {
+	changedAt time.Time
+	expiredAt time.Time
+}
+
+// NewPasswordChangeChecker creates a new PasswordChangeChecker
+func NewPasswordChangeChecker(passwordChangedAt PasswordChangedAtFunc, cacheDuration time.Duration) *PasswordChangeChecker {
+	return &PasswordChangeChecker{
+		passwordChangedAt: passwordChangedAt,
+		cacheDuration:     cacheDuration,
+		cache:             make(map[string]cachedPasswordChange),
+	}
+}
+
+// Check returns ErrTokenBeforePasswordChange if the token was issued before the user last changed their password
+func (checker *PasswordChangeChecker) Check(ctx context.Context, payload *Payload) error {
+	changedAt, err := checker.getPasswordChangedAt(ctx, payload.Username)
+	if err != nil {
+		return err
+	}
+
+	if payload.IssuedAt.Before(changedAt) {
+		return ErrTokenBeforePasswordChange
+	}
+	return nil
+}
+
+// Forget removes the cached password change time of the user,
+// it must be called after the password of the user is changed
+func (checker *PasswordChangeChecker) Forget(username string) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	delete(checker.cache, username)
+}
+
+func (checker *PasswordChangeChecker) getPasswordChangedAt(ctx context.Context, username string) (time.Time, error) {
+	now := time.Now()
+
+	checker.mu.Lock()
+	cached, ok := checker.cache[username]
+	checker.mu.Unlock()
+
+	if ok && now.Before(cached.expiredAt) {
+		return cached.changedAt, nil
+	}
+
+	changedAt, err := checker.passwordChangedAt(ctx, username)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	if len(checker.cache) >= maxCachedPasswordChanges {
+		for key, entry := range checker.cache {
+			if now.After(entry.expiredAt) {
+				delete(checker.cache, key)
+			}
+		}
+	}
+
+	checker.cache[username] = cachedPasswordChange{
+		changedAt: changedAt,
+		expiredAt: now.Add(checker.cacheDuration),
+	}
+	return changedAt, nil
+}
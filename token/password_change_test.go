@@ -0,0 +1,52 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestPasswordChangeChecker(t *testing.T) {
+	username := util.RandomOwner()
+	changedAt := time.Now()
+	lookups := 0
+
+	checker := NewPasswordChangeChecker(func(ctx context.Context, name string) (time.Time, error) {
+		require.Equal(t, username, name)
+		lookups++
+		return changedAt, nil
+	}, time.Minute)
+
+	oldPayload, err := NewPayload(username, util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+	oldPayload.IssuedAt = changedAt.Add(-time.Second)
+
+	newPayload, err := NewPayload(username, util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, checker.Check(context.Background(), oldPayload), ErrTokenBeforePasswordChange)
+	require.NoError(t, checker.Check(context.Background(), newPayload))
+
+	// the password change time is cached
+	require.Equal(t, 1, lookups)
+
+	checker.Forget(username)
+	require.NoError(t, checker.Check(context.Background(), newPayload))
+	require.Equal(t, 2, lookups)
+}
+
+func TestPasswordChangeCheckerLookupError(t *testing.T) {
+	lookupErr := errors.New("user not found")
+	checker := NewPasswordChangeChecker(func(ctx context.Context, username string) (time.Time, error) {
+		return time.Time{}, lookupErr
+	}, time.Minute)
+
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, checker.Check(context.Background(), payload), lookupErr)
+}
@@ -13,15 +13,32 @@ var (
 	ErrInvalidToken = errors.New("token is invalid")
 )
 
+// TokenType tells apart tokens minted by the same Maker for different purposes,
+// so that e.g. a refresh token can never be presented as an access token
+type TokenType byte
+
+// Types of tokens issued by the server
+const (
+	TokenTypeAccessToken  TokenType = 1
+	TokenTypeRefreshToken TokenType = 2
+	TokenTypeMfaChallenge TokenType = 3
+
+	// TokenTypeAPIKey marks the payloads of requests authenticated with an API key.
+	// No Maker ever issues a token of this type.
+	TokenTypeAPIKey TokenType = 4
+)
+
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
+	Type      TokenType `json:"tokenType"`
 	Username  string    `json:"username"`
+	Role      string    `json:"role"`
 	IssuedAt  time.Time `json:"issuedAt"`
 	ExpiredAt time.Time `json:"expiredAt"`
 }
 
-// NewPayload creates a new payload with a specific username and duration
-func NewPayload(username string, duration time.Duration) (*Payload, error) {
+// NewPayload creates a new payload with a specific username, role, duration and token type
+func NewPayload(username string, role string, duration time.Duration, tokenType TokenType) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 
 	if err != nil {
@@ -30,7 +47,9 @@ func NewPayload(username string, duration time.Duration) (*Payload, error) {
 
 	payload := Payload{
 		ID:        tokenID,
+		Type:      tokenType,
 		Username:  username,
+		Role:      role,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -46,3 +65,11 @@ func (payload Payload) Valid() error {
 	}
 	return nil
 }
+
+// checkType makes sure the token was issued for the expected purpose
+func (payload Payload) checkType(tokenType TokenType) error {
+	if payload.Type != tokenType {
+		return ErrInvalidToken
+	}
+	return nil
+}
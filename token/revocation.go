@@ -0,0 +1,104 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevocationCacheDuration is how long a token is cached as not revoked.
+// A token revoked through another server instance is rejected after at most this long.
+const RevocationCacheDuration = 10 * time.Second
+
+// maxCachedRevocations is the cache size above which expired entries are removed
+const maxCachedRevocations = 4096
+
+var ErrRevokedToken = errors.New("token has been revoked")
+
+// IsTokenRevokedFunc reports whether the token with the given ID was revoked
+type IsTokenRevokedFunc func(ctx context.Context, tokenID uuid.UUID) (bool, error)
+
+// RevocationChecker rejects tokens that were revoked before they expired.
+// Lookups are cached, revoked tokens until they expire and the others for a short while,
+// so that checking a token doesn't add a database query to every request.
+type RevocationChecker struct {
+	isTokenRevoked IsTokenRevokedFunc
+	cacheDuration  time.Duration
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedRevocation
+}
+
+type cachedRevocation struct {
+	isRevoked bool
+	expiredAt time.Time
+}
+
+// NewRevocationChecker creates a new RevocationChecker
+func NewRevocationChecker(isTokenRevoked IsTokenRevokedFunc, cacheDuration time.Duration) *RevocationChecker {
+	return &RevocationChecker{
+		isTokenRevoked: isTokenRevoked,
+		cacheDuration:  cacheDuration,
+		cache:          make(map[uuid.UUID]cachedRevocation),
+	}
+}
+
+// Check returns ErrRevokedToken if the token was revoked
+func (checker *RevocationChecker) Check(ctx context.Context, payload *Payload) error {
+	now := time.Now()
+
+	checker.mu.Lock()
+	cached, ok := checker.cache[payload.ID]
+	checker.mu.Unlock()
+
+	if ok && now.Before(cached.expiredAt) {
+		if cached.isRevoked {
+			return ErrRevokedToken
+		}
+		return nil
+	}
+
+	isRevoked, err := checker.isTokenRevoked(ctx, payload.ID)
+	if err != nil {
+		return err
+	}
+
+	expiredAt := payload.ExpiredAt
+	if !isRevoked && now.Add(checker.cacheDuration).Before(expiredAt) {
+		expiredAt = now.Add(checker.cacheDuration)
+	}
+	checker.remember(payload.ID, isRevoked, expiredAt)
+
+	if isRevoked {
+		return ErrRevokedToken
+	}
+	return nil
+}
+
+// Revoke marks the token as revoked in the cache until it expires,
+// it must be called after the token is added to the revocation store
+func (checker *RevocationChecker) Revoke(tokenID uuid.UUID, expiredAt time.Time) {
+	checker.remember(tokenID, true, expiredAt)
+}
+
+func (checker *RevocationChecker) remember(tokenID uuid.UUID, isRevoked bool, expiredAt time.Time) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	if len(checker.cache) >= maxCachedRevocations {
+		now := time.Now()
+		for key, entry := range checker.cache {
+			if now.After(entry.expiredAt) {
+				delete(checker.cache, key)
+			}
+		}
+	}
+
+	checker.cache[tokenID] = cachedRevocation{
+		isRevoked: isRevoked,
+		expiredAt: expiredAt,
+	}
+}
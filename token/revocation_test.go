@@ -0,0 +1,70 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uwemakan/simplebank/util"
+)
+
+func TestRevocationChecker(t *testing.T) {
+	revoked := make(map[uuid.UUID]bool)
+	lookups := 0
+
+	checker := NewRevocationChecker(func(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+		lookups++
+		return revoked[tokenID], nil
+	}, time.Minute)
+
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	revokedPayload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+	revoked[revokedPayload.ID] = true
+
+	require.NoError(t, checker.Check(context.Background(), payload))
+	require.ErrorIs(t, checker.Check(context.Background(), revokedPayload), ErrRevokedToken)
+
+	// both results are cached
+	require.NoError(t, checker.Check(context.Background(), payload))
+	require.ErrorIs(t, checker.Check(context.Background(), revokedPayload), ErrRevokedToken)
+	require.Equal(t, 2, lookups)
+
+	// a token revoked through this checker is rejected right away
+	checker.Revoke(payload.ID, payload.ExpiredAt)
+	require.ErrorIs(t, checker.Check(context.Background(), payload), ErrRevokedToken)
+	require.Equal(t, 2, lookups)
+}
+
+func TestRevocationCheckerCacheExpiry(t *testing.T) {
+	revoked := false
+	checker := NewRevocationChecker(func(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+		return revoked, nil
+	}, time.Millisecond)
+
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+	require.NoError(t, checker.Check(context.Background(), payload))
+
+	// a revocation through another server is seen once the cached result expires
+	revoked = true
+	time.Sleep(2 * time.Millisecond)
+	require.ErrorIs(t, checker.Check(context.Background(), payload), ErrRevokedToken)
+}
+
+func TestRevocationCheckerLookupError(t *testing.T) {
+	lookupErr := errors.New("connection refused")
+	checker := NewRevocationChecker(func(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+		return false, lookupErr
+	}, time.Minute)
+
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute, TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, checker.Check(context.Background(), payload), lookupErr)
+}
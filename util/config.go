@@ -15,12 +15,30 @@ type Config struct {
 	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
 	HttpServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
 	GrpcServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
 	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
 	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
 	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	FxRatesFile          string        `mapstructure:"FX_RATES_FILE"`
+	// base64 Ed25519 seed that signs tokens, and the base64 public keys of rotated out keys still accepted.
+	// The server refuses to start without a signing key, generate one with `openssl rand -base64 32`.
+	TokenSigningKey       string   `mapstructure:"TOKEN_SIGNING_KEY"`
+	TokenVerificationKeys []string `mapstructure:"TOKEN_VERIFICATION_KEYS"`
+	// failed logins within the window slow down further attempts and eventually lock them out;
+	// a threshold of zero disables the lockout
+	LoginFailureWindow      time.Duration `mapstructure:"LOGIN_FAILURE_WINDOW"`
+	LoginLockoutThreshold   int64         `mapstructure:"LOGIN_LOCKOUT_THRESHOLD"`
+	LoginIPLockoutThreshold int64         `mapstructure:"LOGIN_IP_LOCKOUT_THRESHOLD"`
+	LoginLockoutDuration    time.Duration `mapstructure:"LOGIN_LOCKOUT_DURATION"`
+	LoginDelayBase          time.Duration `mapstructure:"LOGIN_DELAY_BASE"`
+	// default transfer limits as comma separated CUR=amount lists, in minor units, see TransferLimits;
+	// a currency without a limit is not limited
+	TransferMaxAmount           []string `mapstructure:"TRANSFER_MAX_AMOUNT"`
+	TransferAccountDailyLimit   []string `mapstructure:"TRANSFER_ACCOUNT_DAILY_LIMIT"`
+	TransferAccountMonthlyLimit []string `mapstructure:"TRANSFER_ACCOUNT_MONTHLY_LIMIT"`
+	TransferUserDailyLimit      []string `mapstructure:"TRANSFER_USER_DAILY_LIMIT"`
+	TransferUserMonthlyLimit    []string `mapstructure:"TRANSFER_USER_MONTHLY_LIMIT"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -1,5 +1,7 @@
 package util
 
+import "fmt"
+
 // Constants for all supported currencies
 const (
 	NGN = "NGN"
@@ -11,11 +13,54 @@ const (
 	CAD = "CAD"
 )
 
+// currencyExponents maps each supported currency to the number of
+// decimal places of its minor unit (ISO 4217 exponent).
+// Money is always stored and transferred as an integer amount of minor units.
+var currencyExponents = map[string]int{
+	NGN: 2,
+	RUB: 2,
+	CNY: 2,
+	USD: 2,
+	GBP: 2,
+	EUR: 2,
+	CAD: 2,
+}
+
 // IsSupportedCurrency returns true if the currency is supported
 func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case NGN, RUB, CNY, USD, GBP, EUR, CAD:
-		return true
+	_, ok := currencyExponents[currency]
+	return ok
+}
+
+// CurrencyExponent returns the number of minor unit decimal places of the currency
+func CurrencyExponent(currency string) (int, error) {
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return 0, fmt.Errorf("unsupported currency: %s", currency)
+	}
+	return exponent, nil
+}
+
+// FormatAmount formats an amount in minor units as a decimal string, e.g. 12345 USD -> "123.45"
+func FormatAmount(amount int64, currency string) (string, error) {
+	exponent, err := CurrencyExponent(currency)
+	if err != nil {
+		return "", err
 	}
-	return false
-}
\ No newline at end of file
+
+	// the magnitude is unsigned, because negating math.MinInt64 overflows an int64
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	digits := fmt.Sprintf("%0*d", exponent+1, magnitude)
+	if exponent == 0 {
+		return sign + digits, nil
+	}
+
+	n := len(digits) - exponent
+	return sign + digits[:n] + "." + digits[n:], nil
+}
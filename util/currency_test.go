@@ -0,0 +1,47 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyExponent(t *testing.T) {
+	for currency := range currencyExponents {
+		require.True(t, IsSupportedCurrency(currency))
+
+		exponent, err := CurrencyExponent(currency)
+		require.NoError(t, err)
+		require.Equal(t, 2, exponent)
+	}
+
+	require.False(t, IsSupportedCurrency("ABC"))
+	_, err := CurrencyExponent("ABC")
+	require.Error(t, err)
+}
+
+func TestFormatAmount(t *testing.T) {
+	testCases := []struct {
+		amount   int64
+		expected string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{10045, "100.45"},
+		{-10045, "-100.45"},
+		{-7, "-0.07"},
+		{100000000, "1000000.00"},
+		{math.MaxInt64, "92233720368547758.07"},
+		{math.MinInt64, "-92233720368547758.08"},
+	}
+
+	for _, tc := range testCases {
+		formatted, err := FormatAmount(tc.amount, USD)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, formatted)
+	}
+
+	_, err := FormatAmount(100, "ABC")
+	require.Error(t, err)
+}
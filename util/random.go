@@ -35,9 +35,9 @@ func RandomOwner() string {
 	return RandomString(6)
 }
 
-// RandomMoney generates a random amount of money
-func RandomMoney() float64 {
-	return float64(RandomInt(0, 1000))
+// RandomMoney generates a random amount of money in minor units
+func RandomMoney() int64 {
+	return RandomInt(0, 100000)
 }
 
 // RandomCurrency generates a random currency
@@ -0,0 +1,57 @@
+package util
+
+import "time"
+
+// Constants for all frequencies of scheduled transfers
+const (
+	OnceFrequency    = "once"
+	WeeklyFrequency  = "weekly"
+	MonthlyFrequency = "monthly"
+)
+
+// IsSupportedFrequency returns true if transfers can be scheduled with the frequency
+func IsSupportedFrequency(frequency string) bool {
+	switch frequency {
+	case OnceFrequency, WeeklyFrequency, MonthlyFrequency:
+		return true
+	}
+	return false
+}
+
+// FirstRun returns the first run of a schedule at or after start.
+// Monthly schedules run on dayOfMonth, or on the last day of shorter months, at the time of day of start.
+// Other schedules first run at start.
+func FirstRun(frequency string, dayOfMonth int32, start time.Time) time.Time {
+	if frequency != MonthlyFrequency {
+		return start
+	}
+
+	run := monthlyRun(start, start.Year(), start.Month(), dayOfMonth)
+	if run.Before(start) {
+		run = monthlyRun(start, start.Year(), start.Month()+1, dayOfMonth)
+	}
+	return run
+}
+
+// NextRun returns the run of a schedule that follows the run at last,
+// and false if a one-off schedule has no more runs
+func NextRun(frequency string, dayOfMonth int32, last time.Time) (time.Time, bool) {
+	switch frequency {
+	case WeeklyFrequency:
+		return last.AddDate(0, 0, 7), true
+	case MonthlyFrequency:
+		return monthlyRun(last, last.Year(), last.Month()+1, dayOfMonth), true
+	}
+	return time.Time{}, false
+}
+
+// monthlyRun returns dayOfMonth of the month, capped to its last day, at the time of day of clock
+func monthlyRun(clock time.Time, year int, month time.Month, dayOfMonth int32) time.Time {
+	// day 0 of the following month is the last day of this one
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, clock.Location()).Day()
+	day := int(dayOfMonth)
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), clock.Location())
+}
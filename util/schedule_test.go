@@ -0,0 +1,47 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstRun(t *testing.T) {
+	start := time.Date(2023, time.January, 15, 9, 30, 0, 0, time.UTC)
+
+	require.Equal(t, start, FirstRun(OnceFrequency, 0, start))
+	require.Equal(t, start, FirstRun(WeeklyFrequency, 0, start))
+
+	// later in the same month
+	require.Equal(t, time.Date(2023, time.January, 28, 9, 30, 0, 0, time.UTC), FirstRun(MonthlyFrequency, 28, start))
+	// already passed this month
+	require.Equal(t, time.Date(2023, time.February, 1, 9, 30, 0, 0, time.UTC), FirstRun(MonthlyFrequency, 1, start))
+	// capped to the last day of February
+	require.Equal(t, time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC), FirstRun(MonthlyFrequency, 31, start.AddDate(0, 0, 17)))
+}
+
+func TestNextRun(t *testing.T) {
+	last := time.Date(2023, time.January, 31, 9, 30, 0, 0, time.UTC)
+
+	_, ok := NextRun(OnceFrequency, 0, last)
+	require.False(t, ok)
+
+	next, ok := NextRun(WeeklyFrequency, 0, last)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2023, time.February, 7, 9, 30, 0, 0, time.UTC), next)
+
+	// the 31st is capped to the end of February, and back to the 31st in March
+	next, ok = NextRun(MonthlyFrequency, 31, last)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC), next)
+
+	next, ok = NextRun(MonthlyFrequency, 31, next)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2023, time.March, 31, 9, 30, 0, 0, time.UTC), next)
+
+	// across the end of the year
+	next, ok = NextRun(MonthlyFrequency, 5, time.Date(2023, time.December, 5, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC), next)
+}
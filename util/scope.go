@@ -0,0 +1,28 @@
+package util
+
+// Constants for all API key scopes
+const (
+	AccountsReadScope   = "accounts:read"
+	AccountsWriteScope  = "accounts:write"
+	TransfersReadScope  = "transfers:read"
+	TransfersWriteScope = "transfers:write"
+)
+
+// IsSupportedScope returns true if the scope is supported
+func IsSupportedScope(scope string) bool {
+	switch scope {
+	case AccountsReadScope, AccountsWriteScope, TransfersReadScope, TransfersWriteScope:
+		return true
+	}
+	return false
+}
+
+// HasScope returns true if scope is one of scopes
+func HasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
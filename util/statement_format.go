@@ -0,0 +1,17 @@
+package util
+
+// Constants for all statement export formats
+const (
+	CSVFormat     = "csv"
+	OFXFormat     = "ofx"
+	Camt053Format = "camt053"
+)
+
+// IsSupportedStatementFormat returns true if statements can be exported in the format
+func IsSupportedStatementFormat(format string) bool {
+	switch format {
+	case CSVFormat, OFXFormat, Camt053Format:
+		return true
+	}
+	return false
+}
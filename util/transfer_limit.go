@@ -0,0 +1,73 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TransferLimits caps the money that may leave through transfers, in minor units of one currency.
+// A zero limit is no limit.
+type TransferLimits struct {
+	// MaxAmount caps a single transfer
+	MaxAmount int64
+	// AccountDaily and AccountMonthly cap the outgoing transfers of an account
+	// since the start of the current UTC day and month
+	AccountDaily   int64
+	AccountMonthly int64
+	// UserDaily and UserMonthly cap the outgoing transfers of a user from all their accounts,
+	// converted into the currency, when they send from an account of the currency
+	UserDaily   int64
+	UserMonthly int64
+}
+
+// TransferLimits returns the default transfer limits of each currency that has any configured
+func (config Config) TransferLimits() (map[string]TransferLimits, error) {
+	limits := make(map[string]TransferLimits)
+
+	fields := []struct {
+		name    string
+		entries []string
+		set     func(limits *TransferLimits, amount int64)
+	}{
+		{"TRANSFER_MAX_AMOUNT", config.TransferMaxAmount, func(l *TransferLimits, a int64) { l.MaxAmount = a }},
+		{"TRANSFER_ACCOUNT_DAILY_LIMIT", config.TransferAccountDailyLimit, func(l *TransferLimits, a int64) { l.AccountDaily = a }},
+		{"TRANSFER_ACCOUNT_MONTHLY_LIMIT", config.TransferAccountMonthlyLimit, func(l *TransferLimits, a int64) { l.AccountMonthly = a }},
+		{"TRANSFER_USER_DAILY_LIMIT", config.TransferUserDailyLimit, func(l *TransferLimits, a int64) { l.UserDaily = a }},
+		{"TRANSFER_USER_MONTHLY_LIMIT", config.TransferUserMonthlyLimit, func(l *TransferLimits, a int64) { l.UserMonthly = a }},
+	}
+
+	for _, field := range fields {
+		for _, entry := range field.entries {
+			currency, amount, err := parseCurrencyAmount(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", field.name, err)
+			}
+
+			currencyLimits := limits[currency]
+			field.set(&currencyLimits, amount)
+			limits[currency] = currencyLimits
+		}
+	}
+
+	return limits, nil
+}
+
+// parseCurrencyAmount parses an amount in minor units of a currency, written as CUR=amount
+func parseCurrencyAmount(entry string) (string, int64, error) {
+	currency, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+	if !found {
+		return "", 0, fmt.Errorf("%q is not of the form CUR=amount", entry)
+	}
+
+	if !IsSupportedCurrency(currency) {
+		return "", 0, fmt.Errorf("unsupported currency: %s", currency)
+	}
+
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || amount < 0 {
+		return "", 0, fmt.Errorf("%q is not a non-negative amount", value)
+	}
+
+	return currency, amount, nil
+}
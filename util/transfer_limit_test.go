@@ -0,0 +1,31 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimits(t *testing.T) {
+	config := Config{
+		TransferMaxAmount:         []string{"USD=1000", " EUR=2000"},
+		TransferAccountDailyLimit: []string{"USD=5000"},
+		TransferUserMonthlyLimit:  []string{"EUR=90000"},
+	}
+
+	limits, err := config.TransferLimits()
+	require.NoError(t, err)
+	require.Equal(t, map[string]TransferLimits{
+		USD: {MaxAmount: 1000, AccountDaily: 5000},
+		EUR: {MaxAmount: 2000, UserMonthly: 90000},
+	}, limits)
+
+	limits, err = Config{}.TransferLimits()
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	for _, entry := range []string{"USD", "ABC=100", "USD=-1", "USD=ten"} {
+		_, err = Config{TransferUserDailyLimit: []string{entry}}.TransferLimits()
+		require.Error(t, err, entry)
+	}
+}
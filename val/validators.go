@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+
+	"github.com/uwemakan/simplebank/totp"
+	"github.com/uwemakan/simplebank/util"
 )
 
 var (
 	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidTotpCode = regexp.MustCompile(`^[0-9]+$`).MatchString
 )
 
 func ValidateString(value string, minLength, maxLength int) error {
@@ -63,3 +67,101 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("is not a supported currency")
+	}
+	return nil
+}
+
+func ValidateRole(value string) error {
+	if !util.IsSupportedRole(value) {
+		return fmt.Errorf("is not a supported role")
+	}
+	return nil
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive amount in minor units")
+	}
+	return nil
+}
+
+func ValidatePageId(value int64) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 10 {
+		return fmt.Errorf("must be between 5 and 10")
+	}
+	return nil
+}
+
+func ValidateTotpCode(value string) error {
+	if len(value) != totp.Digits || !isValidTotpCode(value) {
+		return fmt.Errorf("must contain exactly %d digits", totp.Digits)
+	}
+	return nil
+}
+
+func ValidateRecoveryCode(value string) error {
+	return ValidateString(value, 10, 20)
+}
+
+func ValidateScopes(values []string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("must contain at least one scope")
+	}
+	for _, value := range values {
+		if !util.IsSupportedScope(value) {
+			return fmt.Errorf("%q is not a supported scope", value)
+		}
+	}
+	return nil
+}
+
+func ValidateReference(value string) error {
+	return ValidateString(value, 1, 100)
+}
+
+func ValidateDescription(value string) error {
+	return ValidateString(value, 0, 200)
+}
+
+func ValidateStatementFormat(value string) error {
+	if !util.IsSupportedStatementFormat(value) {
+		return fmt.Errorf("must be csv, ofx or camt053")
+	}
+	return nil
+}
+
+func ValidateFrequency(value string) error {
+	if !util.IsSupportedFrequency(value) {
+		return fmt.Errorf("must be once, weekly or monthly")
+	}
+	return nil
+}
+
+func ValidateDayOfMonth(value int32) error {
+	if value < 1 || value > 31 {
+		return fmt.Errorf("must be between 1 and 31")
+	}
+	return nil
+}
+
+func ValidateReason(value string) error {
+	return ValidateString(value, 0, 200)
+}
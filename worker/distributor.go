@@ -12,6 +12,26 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendPasswordReset(
+		ctx context.Context,
+		payload *PayloadSendPasswordReset,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendLockoutNotification(
+		ctx context.Context,
+		payload *PayloadSendLockoutNotification,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendStatement(
+		ctx context.Context,
+		payload *PayloadSendStatement,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendScheduledTransferFailure(
+		ctx context.Context,
+		payload *PayloadSendScheduledTransferFailure,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -17,12 +17,23 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPasswordReset(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendLockoutNotification(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPruneRevokedTokens(ctx context.Context, task *asynq.Task) error
+	ProcessTaskVerifyLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendMonthlyStatements(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error
+	ProcessTaskRunScheduledTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendScheduledTransferFailure(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpireHolds(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  db.Store
 	mailer mail.EmailSender
+	// distributor enqueues the tasks that a task fans out into
+	distributor TaskDistributor
 }
 
 func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
@@ -43,14 +54,24 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 		},
 	)
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		distributor: NewRedisTaskDistributor(redisOpt),
 	}
 }
 
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendPasswordReset, processor.ProcessTaskSendPasswordReset)
+	mux.HandleFunc(TaskSendLockoutNotification, processor.ProcessTaskSendLockoutNotification)
+	mux.HandleFunc(TaskPruneRevokedTokens, processor.ProcessTaskPruneRevokedTokens)
+	mux.HandleFunc(TaskVerifyLedger, processor.ProcessTaskVerifyLedger)
+	mux.HandleFunc(TaskSendMonthlyStatements, processor.ProcessTaskSendMonthlyStatements)
+	mux.HandleFunc(TaskSendStatement, processor.ProcessTaskSendStatement)
+	mux.HandleFunc(TaskRunScheduledTransfers, processor.ProcessTaskRunScheduledTransfers)
+	mux.HandleFunc(TaskSendScheduledTransferFailure, processor.ProcessTaskSendScheduledTransferFailure)
+	mux.HandleFunc(TaskExpireHolds, processor.ProcessTaskExpireHolds)
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// TaskScheduler enqueues the periodic maintenance tasks
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			EnqueueErrorHandler: func(task *asynq.Task, opts []asynq.Option, err error) {
+				log.Error().Err(err).
+					Str("type", task.Type()).
+					Msg("schedule task failed")
+			},
+			Logger: NewLogger(),
+		},
+	)
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}
+}
+
+// periodicTask is a task enqueued on a cron schedule. Unique keeps several running
+// schedulers from enqueuing the same run more than once.
+type periodicTask struct {
+	cronspec string
+	taskType string
+	unique   time.Duration
+}
+
+var periodicTasks = []periodicTask{
+	{cronspec: "@hourly", taskType: TaskPruneRevokedTokens, unique: time.Hour},
+	{cronspec: "@daily", taskType: TaskVerifyLedger, unique: 24 * time.Hour},
+	{cronspec: "@every 1m", taskType: TaskRunScheduledTransfers, unique: time.Minute},
+	{cronspec: "@every 5m", taskType: TaskExpireHolds, unique: 5 * time.Minute},
+	// 06:00 UTC on the first day of every month
+	{cronspec: "0 6 1 * *", taskType: TaskSendMonthlyStatements, unique: 24 * time.Hour},
+}
+
+func (scheduler *RedisTaskScheduler) Start() error {
+	for _, periodic := range periodicTasks {
+		task := asynq.NewTask(periodic.taskType, nil)
+		_, err := scheduler.scheduler.Register(
+			periodic.cronspec,
+			task,
+			asynq.Queue(QueueDefault),
+			asynq.Unique(periodic.unique),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to register periodic task %s: %w", periodic.taskType, err)
+		}
+	}
+	return scheduler.scheduler.Start()
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+const TaskExpireHolds = "task:expire_holds"
+
+// expireHoldsBatchSize is how many stale holds one run of the task releases
+const expireHoldsBatchSize = 500
+
+// ProcessTaskExpireHolds releases the authorized holds that outlived their expiry,
+// giving the held money back to the available balance of their accounts.
+// A hold captured or voided in the meantime is skipped.
+func (processor *RedisTaskProcessor) ProcessTaskExpireHolds(ctx context.Context, task *asynq.Task) error {
+	holdIDs, err := processor.store.ListExpiredHolds(ctx, expireHoldsBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list expired holds: %w", err)
+	}
+
+	var expired int
+	for _, holdID := range holdIDs {
+		_, err := processor.store.ExpireHoldTx(ctx, holdID)
+		if err != nil {
+			if errors.Is(err, db.ErrHoldNotAuthorized) {
+				continue
+			}
+			// the hold is listed again on the next run
+			log.Error().Err(err).
+				Int64("hold_id", holdID).
+				Msg("failed to expire hold")
+			continue
+		}
+		expired++
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("expired", expired).
+		Msg("processed task")
+	return nil
+}
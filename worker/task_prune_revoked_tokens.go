@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskPruneRevokedTokens = "task:prune_revoked_tokens"
+
+// ProcessTaskPruneRevokedTokens deletes the revoked access tokens that have expired anyway
+func (processor *RedisTaskProcessor) ProcessTaskPruneRevokedTokens(ctx context.Context, task *asynq.Task) error {
+	pruned, err := processor.store.DeleteExpiredRevokedTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired revoked tokens: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int64("pruned", pruned).
+		Msg("processed task")
+	return nil
+}
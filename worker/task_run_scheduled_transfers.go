@@ -0,0 +1,182 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/util"
+)
+
+const TaskRunScheduledTransfers = "task:run_scheduled_transfers"
+
+const (
+	// scheduledTransfersBatchSize is how many due transfers one run of the task claims
+	scheduledTransfersBatchSize = 100
+	// scheduledTransferLease keeps a claimed transfer from being claimed again while it runs
+	scheduledTransferLease = 5 * time.Minute
+	// maxScheduledTransferAttempts is how often a run is attempted before it is given up
+	maxScheduledTransferAttempts = 3
+	// scheduledTransferRetryDelay is multiplied by the failed attempts so far to space out retries
+	scheduledTransferRetryDelay = time.Hour
+)
+
+var errCurrencyMismatch = errors.New("scheduled transfers need both accounts in the same currency")
+
+// ProcessTaskRunScheduledTransfers claims the scheduled transfers that are due and runs them.
+// A failed run is retried a few times, and the owner is notified of every failure.
+// Once a run is given up, a one-off transfer fails and a recurring one moves on to its next run.
+func (processor *RedisTaskProcessor) ProcessTaskRunScheduledTransfers(ctx context.Context, task *asynq.Task) error {
+	scheduledTransfers, err := processor.store.ClaimDueScheduledTransfers(ctx, db.ClaimDueScheduledTransfersParams{
+		ClaimedUntil: time.Now().Add(scheduledTransferLease),
+		Limit:        scheduledTransfersBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim due scheduled transfers: %w", err)
+	}
+
+	var failed int
+	for _, scheduledTransfer := range scheduledTransfers {
+		if err := processor.runScheduledTransfer(ctx, scheduledTransfer); err != nil {
+			// the lease expires and the transfer is claimed again
+			log.Error().Err(err).
+				Int64("scheduled_transfer_id", scheduledTransfer.ID).
+				Msg("failed to run scheduled transfer")
+			failed++
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("claimed", len(scheduledTransfers)).
+		Int("failed", failed).
+		Msg("processed task")
+	return nil
+}
+
+// runScheduledTransfer runs the due transfer and records the outcome.
+// The idempotency key of the run keeps a run that succeeded, but could not be recorded, from moving money twice.
+// A transfer cancelled while it ran, by its owner or by closing an account, stays cancelled.
+func (processor *RedisTaskProcessor) runScheduledTransfer(ctx context.Context, scheduledTransfer db.ScheduledTransfer) error {
+	result, transferErr := processor.transferScheduled(ctx, scheduledTransfer)
+
+	arg := db.RecordScheduledTransferRunParams{
+		ID:             scheduledTransfer.ID,
+		NextRunAt:      scheduledTransfer.NextRunAt,
+		Status:         db.ScheduledTransferStatusActive,
+		LastTransferID: scheduledTransfer.LastTransferID,
+	}
+
+	if transferErr == nil {
+		arg.LastTransferID = result.Transfer.ID
+		advanceScheduledTransfer(scheduledTransfer, &arg)
+		_, err := processor.store.RecordScheduledTransferRun(ctx, arg)
+		if err == db.ErrRecordNotFound {
+			logCancelledScheduledTransfer(scheduledTransfer)
+			return nil
+		}
+		return err
+	}
+
+	arg.FailedAttempts = scheduledTransfer.FailedAttempts + 1
+	arg.LastError = transferErr.Error()
+
+	willRetry := arg.FailedAttempts < maxScheduledTransferAttempts
+	if willRetry {
+		arg.ClaimedUntil = time.Now().Add(time.Duration(arg.FailedAttempts) * scheduledTransferRetryDelay)
+	} else {
+		advanceScheduledTransfer(scheduledTransfer, &arg)
+		if arg.Status == db.ScheduledTransferStatusCompleted {
+			arg.Status = db.ScheduledTransferStatusFailed
+		}
+	}
+
+	updated, err := processor.store.RecordScheduledTransferRun(ctx, arg)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			logCancelledScheduledTransfer(scheduledTransfer)
+			return nil
+		}
+		return err
+	}
+
+	payload := &PayloadSendScheduledTransferFailure{
+		ScheduledTransferID: updated.ID,
+		Username:            updated.Owner,
+		Error:               updated.LastError,
+		WillRetry:           willRetry,
+		RetryAt:             arg.ClaimedUntil,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueCritical),
+	}
+	if err := processor.distributor.DistributeTaskSendScheduledTransferFailure(ctx, payload, opts...); err != nil {
+		// the failure is recorded, only the email is lost
+		log.Error().Err(err).Msg("failed to send scheduled transfer failure")
+	}
+	return nil
+}
+
+func logCancelledScheduledTransfer(scheduledTransfer db.ScheduledTransfer) {
+	log.Info().
+		Int64("scheduled_transfer_id", scheduledTransfer.ID).
+		Msg("scheduled transfer was cancelled while it ran")
+}
+
+// advanceScheduledTransfer sets arg to the next run of the transfer with a clean slate,
+// or completes a one-off transfer
+func advanceScheduledTransfer(scheduledTransfer db.ScheduledTransfer, arg *db.RecordScheduledTransferRunParams) {
+	nextRunAt, ok := util.NextRun(scheduledTransfer.Frequency, scheduledTransfer.DayOfMonth, scheduledTransfer.NextRunAt)
+	if !ok {
+		arg.Status = db.ScheduledTransferStatusCompleted
+		return
+	}
+
+	arg.NextRunAt = nextRunAt
+	arg.FailedAttempts = 0
+}
+
+func (processor *RedisTaskProcessor) transferScheduled(ctx context.Context, scheduledTransfer db.ScheduledTransfer) (db.TransferTxResult, error) {
+	var result db.TransferTxResult
+
+	fromAccount, err := processor.store.GetAccount(ctx, scheduledTransfer.FromAccountID)
+	if err != nil {
+		return result, fmt.Errorf("failed to get from account: %w", err)
+	}
+
+	toAccount, err := processor.store.GetAccount(ctx, scheduledTransfer.ToAccountID)
+	if err != nil {
+		return result, fmt.Errorf("failed to get to account: %w", err)
+	}
+
+	if fromAccount.Owner != scheduledTransfer.Owner {
+		return result, fmt.Errorf("from account doesn't belong to %s", scheduledTransfer.Owner)
+	}
+	if fromAccount.Currency != toAccount.Currency {
+		return result, errCurrencyMismatch
+	}
+
+	// the amount can be updated between a failed attempt and its retry, so it is left out of the hash;
+	// a retry of a run that did go through replays that transfer instead of failing on a reused key
+	idempotencyKey := fmt.Sprintf("scheduled_transfer:%d:%d", scheduledTransfer.ID, scheduledTransfer.NextRunAt.Unix())
+	data := fmt.Sprintf("%d:%d", scheduledTransfer.FromAccountID, scheduledTransfer.ToAccountID)
+	hash := sha256.Sum256([]byte(data))
+
+	return processor.store.TransferTx(ctx, db.TransferTxParams{
+		FromAccountID:  fromAccount.ID,
+		ToAccountID:    toAccount.ID,
+		Amount:         scheduledTransfer.Amount,
+		Sender:         fromAccount.Owner,
+		Recipient:      toAccount.Owner,
+		IdempotencyKey: idempotencyKey,
+		RequestHash:    hex.EncodeToString(hash[:]),
+		Scheduled:      true,
+	})
+}
@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+const TaskSendLockoutNotification = "task:send_lockout_notification"
+
+type PayloadSendLockoutNotification struct {
+	Username       string    `json:"username"`
+	ClientIP       string    `json:"client_ip"`
+	FailedAttempts int64     `json:"failed_attempts"`
+	LockedUntil    time.Time `json:"locked_until"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendLockoutNotification(
+	ctx context.Context,
+	payload *PayloadSendLockoutNotification,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendLockoutNotification, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("maxRetry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendLockoutNotification(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendLockoutNotification
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	subject := "Sign-in to your Simple Bank account was locked"
+	content := fmt.Sprintf(`
+		Hello %s,</br>
+		We blocked sign-in to your account after %d failed login attempts, the last one from IP address %s.</br>
+		You can try again after %s.</br>
+		If this wasn't you, someone may be trying to guess your password. Consider changing it once you can sign in again.<br/>
+	`, user.FullName, payload.FailedAttempts, payload.ClientIP, payload.LockedUntil.UTC().Format(time.RFC1123))
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send lockout notification email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+	return nil
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+const TaskSendMonthlyStatements = "task:send_monthly_statements"
+
+// statementRecipientsPageSize is how many users are read at once when fanning out the monthly statements
+const statementRecipientsPageSize = 100
+
+// ProcessTaskSendMonthlyStatements enqueues a statement email of the previous calendar month
+// for every user with a verified email address and a customer account.
+// Each email is a task of its own, with an id derived from the user and the month,
+// so that a retry of this task does not send anybody the same statement twice.
+func (processor *RedisTaskProcessor) ProcessTaskSendMonthlyStatements(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	toTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	var afterUsername string
+	var enqueued int
+	for {
+		usernames, err := processor.store.ListStatementRecipients(ctx, db.ListStatementRecipientsParams{
+			AfterUsername: afterUsername,
+			Limit:         statementRecipientsPageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list statement recipients: %w", err)
+		}
+
+		for _, username := range usernames {
+			payload := &PayloadSendStatement{
+				Username: username,
+				FromTime: fromTime,
+				ToTime:   toTime,
+			}
+			taskID := fmt.Sprintf("statement:%s:%s", username, fromTime.Format("2006-01"))
+			err := processor.distributor.DistributeTaskSendStatement(
+				ctx,
+				payload,
+				asynq.TaskID(taskID),
+				asynq.MaxRetry(10),
+				asynq.Queue(QueueDefault),
+			)
+			if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+				return err
+			}
+			enqueued++
+		}
+
+		if len(usernames) < statementRecipientsPageSize {
+			break
+		}
+		afterUsername = usernames[len(usernames)-1]
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("from_time", fromTime).
+		Int("statements", enqueued).
+		Msg("processed task")
+	return nil
+}
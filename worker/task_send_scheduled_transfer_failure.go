@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+)
+
+const TaskSendScheduledTransferFailure = "task:send_scheduled_transfer_failure"
+
+type PayloadSendScheduledTransferFailure struct {
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	Username            string    `json:"username"`
+	Error               string    `json:"error"`
+	WillRetry           bool      `json:"will_retry"`
+	RetryAt             time.Time `json:"retry_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendScheduledTransferFailure(
+	ctx context.Context,
+	payload *PayloadSendScheduledTransferFailure,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendScheduledTransferFailure, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("maxRetry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendScheduledTransferFailure(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendScheduledTransferFailure
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	outcome := "We will not retry this payment."
+	if payload.WillRetry {
+		outcome = fmt.Sprintf("We will try again after %s.", payload.RetryAt.UTC().Format(time.RFC1123))
+	}
+
+	subject := "A scheduled transfer from your Simple Bank account failed"
+	content := fmt.Sprintf(`
+		Hello %s,</br>
+		Your scheduled transfer #%d could not be made: %s.</br>
+		%s<br/>
+	`, user.FullName, payload.ScheduledTransferID, payload.Error, outcome)
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send scheduled transfer failure email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+	return nil
+}
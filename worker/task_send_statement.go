@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/uwemakan/simplebank/db/sqlc"
+	"github.com/uwemakan/simplebank/statement"
+	"github.com/uwemakan/simplebank/util"
+)
+
+const TaskSendStatement = "task:send_statement"
+
+// statementEmailFormats are the formats every statement is attached in
+var statementEmailFormats = []string{util.CSVFormat, util.OFXFormat, util.Camt053Format}
+
+// maxAccountsPerUser bounds the accounts listed for a statement email.
+// A user has at most one customer account per currency.
+const maxAccountsPerUser = 100
+
+type PayloadSendStatement struct {
+	Username string    `json:"username"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendStatement(
+	ctx context.Context,
+	payload *PayloadSendStatement,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskSendStatement, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("maxRetry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendStatement emails a user the statements of all their customer accounts over a period,
+// attached in every export format
+func (processor *RedisTaskProcessor) ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendStatement
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	accounts, err := processor.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner: user.Username,
+		Limit: maxAccountsPerUser,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "statements-")
+	if err != nil {
+		return fmt.Errorf("failed to create statements directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var attachFiles []string
+	for _, account := range accounts {
+		if account.Kind != db.AccountKindCustomer {
+			continue
+		}
+
+		result, err := processor.store.StatementTx(ctx, db.StatementTxParams{
+			AccountID: account.ID,
+			FromTime:  payload.FromTime,
+			ToTime:    payload.ToTime,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get statement of account %d: %w", account.ID, err)
+		}
+
+		for _, format := range statementEmailFormats {
+			file, err := statement.Export(format, statement.Statement{
+				Account:           account,
+				OwnerName:         user.FullName,
+				StatementTxResult: result,
+				CreatedAt:         time.Now(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export statement of account %d: %w", account.ID, err)
+			}
+
+			path := filepath.Join(dir, file.Name)
+			if err := os.WriteFile(path, file.Content, 0600); err != nil {
+				return fmt.Errorf("failed to write statement file: %w", err)
+			}
+			attachFiles = append(attachFiles, path)
+		}
+	}
+
+	if len(attachFiles) == 0 {
+		log.Info().
+			Str("type", task.Type()).
+			Bytes("payload", task.Payload()).
+			Msg("no accounts to send statements of")
+		return nil
+	}
+
+	subject := fmt.Sprintf("Your Simple Bank statements for %s", payload.FromTime.UTC().Format("January 2006"))
+	content := fmt.Sprintf(`
+		Hello %s,</br>
+		Your account statements from %s to %s are attached as CSV, OFX and ISO 20022 camt.053 files.</br>
+		The CSV files open in any spreadsheet, and accounting software can import the OFX and camt.053 files.<br/>
+	`, user.FullName, payload.FromTime.UTC().Format("2 January 2006"), payload.ToTime.UTC().Format("2 January 2006"))
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, attachFiles)
+	if err != nil {
+		return fmt.Errorf("failed to send statement email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Int("attachments", len(attachFiles)).
+		Msg("processed task")
+	return nil
+}
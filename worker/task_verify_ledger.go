@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskVerifyLedger = "task:verify_ledger"
+
+// ProcessTaskVerifyLedger reports every account whose balance is not the sum of its entries,
+// and every journal that does not balance. Retrying would find the same inconsistencies,
+// so they are logged rather than failing the task.
+func (processor *RedisTaskProcessor) ProcessTaskVerifyLedger(ctx context.Context, task *asynq.Task) error {
+	report, err := processor.store.VerifyLedger(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to verify ledger: %w", err)
+	}
+
+	for _, mismatch := range report.BalanceMismatches {
+		log.Error().
+			Int64("account_id", mismatch.ID).
+			Str("owner", mismatch.Owner).
+			Str("currency", mismatch.Currency).
+			Int64("balance", mismatch.Balance).
+			Int64("entry_sum", mismatch.EntrySum).
+			Msg("account balance does not match its entries")
+	}
+
+	for _, journal := range report.UnbalancedJournals {
+		log.Error().
+			Int64("journal_id", journal.JournalID).
+			Str("currency", journal.Currency).
+			Int64("total", journal.Total).
+			Msg("journal does not balance")
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bool("consistent", report.IsConsistent()).
+		Msg("processed task")
+	return nil
+}